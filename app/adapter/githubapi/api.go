@@ -1,4 +1,4 @@
-//go:generate mockgen -package githubapi -destination api_mock.go . Client,RepositoriesService,PullRequestService,AppsService,UsersService
+//go:generate mockgen -package githubapi -destination api_mock.go . Client,RepositoriesService,PullRequestService,AppsService,UsersService,ChecksService,OrganizationsService
 
 package githubapi
 
@@ -16,6 +16,8 @@ func New(client *github.Client) Client {
 
 type Client interface {
 	Apps() AppsService
+	Checks() ChecksService
+	Organizations() OrganizationsService
 	PullRequests() PullRequestService
 	Repositories() RepositoriesService
 	Users() UsersService
@@ -29,6 +31,14 @@ func (c *clientImpl) Apps() AppsService {
 	return c.ghClient.Apps
 }
 
+func (c *clientImpl) Checks() ChecksService {
+	return c.ghClient.Checks
+}
+
+func (c *clientImpl) Organizations() OrganizationsService {
+	return c.ghClient.Organizations
+}
+
 func (c *clientImpl) PullRequests() PullRequestService {
 	return c.ghClient.PullRequests
 }
@@ -54,6 +64,16 @@ type AppsService interface {
 	ListInstallations(ctx context.Context, opts *github.ListOptions) ([]*github.Installation, *github.Response, error)
 	ListUserRepos(ctx context.Context, id int64, opts *github.ListOptions) ([]*github.Repository, *github.Response, error)
 	ListUserInstallations(ctx context.Context, opts *github.ListOptions) ([]*github.Installation, *github.Response, error)
+	FindRepositoryInstallation(ctx context.Context, owner, repo string) (*github.Installation, *github.Response, error)
+}
+
+type ChecksService interface {
+	CreateCheckRun(ctx context.Context, owner, repo string, opts github.CreateCheckRunOptions) (*github.CheckRun, *github.Response, error)
+	UpdateCheckRun(ctx context.Context, owner, repo string, checkRunID int64, opts github.UpdateCheckRunOptions) (*github.CheckRun, *github.Response, error)
+}
+
+type OrganizationsService interface {
+	GetOrgMembership(ctx context.Context, user, org string) (*github.Membership, *github.Response, error)
 }
 
 type UsersService interface {
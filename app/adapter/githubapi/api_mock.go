@@ -1,5 +1,5 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/aereal/merge-chance-time/app/adapter/githubapi (interfaces: Client,RepositoriesService,PullRequestService,AppsService,UsersService)
+// Source: github.com/aereal/merge-chance-time/app/adapter/githubapi (interfaces: Client,RepositoriesService,PullRequestService,AppsService,UsersService,ChecksService,OrganizationsService)
 
 // Package githubapi is a generated GoMock package.
 package githubapi
@@ -48,6 +48,34 @@ func (mr *MockClientMockRecorder) Apps() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Apps", reflect.TypeOf((*MockClient)(nil).Apps))
 }
 
+// Checks mocks base method
+func (m *MockClient) Checks() ChecksService {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Checks")
+	ret0, _ := ret[0].(ChecksService)
+	return ret0
+}
+
+// Checks indicates an expected call of Checks
+func (mr *MockClientMockRecorder) Checks() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Checks", reflect.TypeOf((*MockClient)(nil).Checks))
+}
+
+// Organizations mocks base method
+func (m *MockClient) Organizations() OrganizationsService {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Organizations")
+	ret0, _ := ret[0].(OrganizationsService)
+	return ret0
+}
+
+// Organizations indicates an expected call of Organizations
+func (mr *MockClientMockRecorder) Organizations() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Organizations", reflect.TypeOf((*MockClient)(nil).Organizations))
+}
+
 // PullRequests mocks base method
 func (m *MockClient) PullRequests() PullRequestService {
 	m.ctrl.T.Helper()
@@ -207,6 +235,22 @@ func (m *MockAppsService) EXPECT() *MockAppsServiceMockRecorder {
 	return m.recorder
 }
 
+// FindRepositoryInstallation mocks base method
+func (m *MockAppsService) FindRepositoryInstallation(arg0 context.Context, arg1, arg2 string) (*github.Installation, *github.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindRepositoryInstallation", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*github.Installation)
+	ret1, _ := ret[1].(*github.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindRepositoryInstallation indicates an expected call of FindRepositoryInstallation
+func (mr *MockAppsServiceMockRecorder) FindRepositoryInstallation(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindRepositoryInstallation", reflect.TypeOf((*MockAppsService)(nil).FindRepositoryInstallation), arg0, arg1, arg2)
+}
+
 // ListInstallations mocks base method
 func (m *MockAppsService) ListInstallations(arg0 context.Context, arg1 *github.ListOptions) ([]*github.Installation, *github.Response, error) {
 	m.ctrl.T.Helper()
@@ -293,3 +337,97 @@ func (mr *MockUsersServiceMockRecorder) Get(arg0, arg1 interface{}) *gomock.Call
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockUsersService)(nil).Get), arg0, arg1)
 }
+
+// MockChecksService is a mock of ChecksService interface
+type MockChecksService struct {
+	ctrl     *gomock.Controller
+	recorder *MockChecksServiceMockRecorder
+}
+
+// MockChecksServiceMockRecorder is the mock recorder for MockChecksService
+type MockChecksServiceMockRecorder struct {
+	mock *MockChecksService
+}
+
+// NewMockChecksService creates a new mock instance
+func NewMockChecksService(ctrl *gomock.Controller) *MockChecksService {
+	mock := &MockChecksService{ctrl: ctrl}
+	mock.recorder = &MockChecksServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockChecksService) EXPECT() *MockChecksServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateCheckRun mocks base method
+func (m *MockChecksService) CreateCheckRun(arg0 context.Context, arg1, arg2 string, arg3 github.CreateCheckRunOptions) (*github.CheckRun, *github.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCheckRun", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*github.CheckRun)
+	ret1, _ := ret[1].(*github.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateCheckRun indicates an expected call of CreateCheckRun
+func (mr *MockChecksServiceMockRecorder) CreateCheckRun(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCheckRun", reflect.TypeOf((*MockChecksService)(nil).CreateCheckRun), arg0, arg1, arg2, arg3)
+}
+
+// UpdateCheckRun mocks base method
+func (m *MockChecksService) UpdateCheckRun(arg0 context.Context, arg1, arg2 string, arg3 int64, arg4 github.UpdateCheckRunOptions) (*github.CheckRun, *github.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCheckRun", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(*github.CheckRun)
+	ret1, _ := ret[1].(*github.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// UpdateCheckRun indicates an expected call of UpdateCheckRun
+func (mr *MockChecksServiceMockRecorder) UpdateCheckRun(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCheckRun", reflect.TypeOf((*MockChecksService)(nil).UpdateCheckRun), arg0, arg1, arg2, arg3, arg4)
+}
+
+// MockOrganizationsService is a mock of OrganizationsService interface
+type MockOrganizationsService struct {
+	ctrl     *gomock.Controller
+	recorder *MockOrganizationsServiceMockRecorder
+}
+
+// MockOrganizationsServiceMockRecorder is the mock recorder for MockOrganizationsService
+type MockOrganizationsServiceMockRecorder struct {
+	mock *MockOrganizationsService
+}
+
+// NewMockOrganizationsService creates a new mock instance
+func NewMockOrganizationsService(ctrl *gomock.Controller) *MockOrganizationsService {
+	mock := &MockOrganizationsService{ctrl: ctrl}
+	mock.recorder = &MockOrganizationsServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockOrganizationsService) EXPECT() *MockOrganizationsServiceMockRecorder {
+	return m.recorder
+}
+
+// GetOrgMembership mocks base method
+func (m *MockOrganizationsService) GetOrgMembership(arg0 context.Context, arg1, arg2 string) (*github.Membership, *github.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrgMembership", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*github.Membership)
+	ret1, _ := ret[1].(*github.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetOrgMembership indicates an expected call of GetOrgMembership
+func (mr *MockOrganizationsServiceMockRecorder) GetOrgMembership(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrgMembership", reflect.TypeOf((*MockOrganizationsService)(nil).GetOrgMembership), arg0, arg1, arg2)
+}
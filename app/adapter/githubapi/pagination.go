@@ -0,0 +1,89 @@
+package githubapi
+
+import (
+	"context"
+
+	"github.com/google/go-github/v30/github"
+)
+
+// perPage is the maximum page size GitHub accepts.
+const perPage = 100
+
+// ListAllPullRequests lists the pull requests on every page.
+// opts is copied, so the caller can reuse it.
+func ListAllPullRequests(ctx context.Context, svc PullRequestService, owner, repo string, opts *github.PullRequestListOptions) ([]*github.PullRequest, error) {
+	o := github.PullRequestListOptions{}
+	if opts != nil {
+		o = *opts
+	}
+	o.PerPage = perPage
+	all := []*github.PullRequest{}
+	for {
+		prs, resp, err := svc.List(ctx, owner, repo, &o)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, prs...)
+		if resp == nil || resp.NextPage == 0 {
+			return all, nil
+		}
+		o.Page = resp.NextPage
+	}
+}
+
+// ListAllInstallations lists the installations of the app on every page.
+func ListAllInstallations(ctx context.Context, svc AppsService) ([]*github.Installation, error) {
+	all := []*github.Installation{}
+	err := eachPage(func(opts *github.ListOptions) (*github.Response, error) {
+		installations, resp, err := svc.ListInstallations(ctx, opts)
+		all = append(all, installations...)
+		return resp, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return all, nil
+}
+
+// ListAllUserInstallations lists the installations accessible to the user on every page.
+func ListAllUserInstallations(ctx context.Context, svc AppsService) ([]*github.Installation, error) {
+	all := []*github.Installation{}
+	err := eachPage(func(opts *github.ListOptions) (*github.Response, error) {
+		installations, resp, err := svc.ListUserInstallations(ctx, opts)
+		all = append(all, installations...)
+		return resp, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return all, nil
+}
+
+// ListAllUserRepos lists the repositories of the installation accessible to the user on every page.
+func ListAllUserRepos(ctx context.Context, svc AppsService, id int64) ([]*github.Repository, error) {
+	all := []*github.Repository{}
+	err := eachPage(func(opts *github.ListOptions) (*github.Response, error) {
+		repos, resp, err := svc.ListUserRepos(ctx, id, opts)
+		all = append(all, repos...)
+		return resp, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return all, nil
+}
+
+// eachPage calls list with the options of each page until the response has no next page.
+func eachPage(list func(opts *github.ListOptions) (*github.Response, error)) error {
+	opts := &github.ListOptions{PerPage: perPage}
+	for {
+		resp, err := list(opts)
+		if err != nil {
+			return err
+		}
+		if resp == nil || resp.NextPage == 0 {
+			return nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
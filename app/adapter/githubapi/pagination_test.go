@@ -0,0 +1,137 @@
+package githubapi
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-github/v30/github"
+)
+
+type pageMatcher int
+
+func (m pageMatcher) Matches(x interface{}) bool {
+	switch opts := x.(type) {
+	case *github.ListOptions:
+		return opts.Page == int(m) && opts.PerPage == perPage
+	case *github.PullRequestListOptions:
+		return opts.Page == int(m) && opts.PerPage == perPage && opts.State == "open"
+	default:
+		return false
+	}
+}
+
+func (m pageMatcher) String() string {
+	return fmt.Sprintf("is options of page %d", m)
+}
+
+func TestListAllPullRequests(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	svc := NewMockPullRequestService(ctrl)
+	gomock.InOrder(
+		svc.EXPECT().List(gomock.Any(), "aereal", "example-repo", pageMatcher(0)).
+			Return([]*github.PullRequest{{Number: github.Int(1)}}, &github.Response{NextPage: 2}, nil),
+		svc.EXPECT().List(gomock.Any(), "aereal", "example-repo", pageMatcher(2)).
+			Return([]*github.PullRequest{{Number: github.Int(2)}}, &github.Response{}, nil),
+	)
+	opts := &github.PullRequestListOptions{State: "open"}
+	got, err := ListAllPullRequests(context.Background(), svc, "aereal", "example-repo", opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 2 || got[0].GetNumber() != 1 || got[1].GetNumber() != 2 {
+		t.Errorf("ListAllPullRequests() = %v", got)
+	}
+	if opts.Page != 0 {
+		t.Errorf("opts is modified: %#v", opts)
+	}
+}
+
+func TestListAllInstallations(t *testing.T) {
+	tests := []struct {
+		name    string
+		pages   func(svc *MockAppsService)
+		wantIDs []int64
+		wantErr bool
+	}{
+		{
+			name: "single page",
+			pages: func(svc *MockAppsService) {
+				svc.EXPECT().ListInstallations(gomock.Any(), pageMatcher(0)).
+					Return([]*github.Installation{{ID: github.Int64(1)}}, &github.Response{}, nil)
+			},
+			wantIDs: []int64{1},
+		},
+		{
+			name: "multiple pages",
+			pages: func(svc *MockAppsService) {
+				gomock.InOrder(
+					svc.EXPECT().ListInstallations(gomock.Any(), pageMatcher(0)).
+						Return([]*github.Installation{{ID: github.Int64(1)}, {ID: github.Int64(2)}}, &github.Response{NextPage: 2}, nil),
+					svc.EXPECT().ListInstallations(gomock.Any(), pageMatcher(2)).
+						Return([]*github.Installation{{ID: github.Int64(3)}}, &github.Response{NextPage: 3}, nil),
+					svc.EXPECT().ListInstallations(gomock.Any(), pageMatcher(3)).
+						Return([]*github.Installation{}, &github.Response{}, nil),
+				)
+			},
+			wantIDs: []int64{1, 2, 3},
+		},
+		{
+			name: "error on a page",
+			pages: func(svc *MockAppsService) {
+				gomock.InOrder(
+					svc.EXPECT().ListInstallations(gomock.Any(), pageMatcher(0)).
+						Return([]*github.Installation{{ID: github.Int64(1)}}, &github.Response{NextPage: 2}, nil),
+					svc.EXPECT().ListInstallations(gomock.Any(), pageMatcher(2)).
+						Return(nil, nil, fmt.Errorf("oops")),
+				)
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			svc := NewMockAppsService(ctrl)
+			tt.pages(svc)
+			got, err := ListAllInstallations(context.Background(), svc)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ListAllInstallations() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if len(got) != len(tt.wantIDs) {
+				t.Fatalf("len(ListAllInstallations()) = %d, want %d", len(got), len(tt.wantIDs))
+			}
+			for i, id := range tt.wantIDs {
+				if got[i].GetID() != id {
+					t.Errorf("ListAllInstallations()[%d].ID = %d, want %d", i, got[i].GetID(), id)
+				}
+			}
+		})
+	}
+}
+
+func TestListAllUserRepos(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	svc := NewMockAppsService(ctrl)
+	gomock.InOrder(
+		svc.EXPECT().ListUserRepos(gomock.Any(), int64(1234), pageMatcher(0)).
+			Return([]*github.Repository{{Name: github.String("a")}}, &github.Response{NextPage: 2}, nil),
+		svc.EXPECT().ListUserRepos(gomock.Any(), int64(1234), pageMatcher(2)).
+			Return([]*github.Repository{{Name: github.String("b")}}, &github.Response{}, nil),
+	)
+	got, err := ListAllUserRepos(context.Background(), svc, 1234)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 2 || got[0].GetName() != "a" || got[1].GetName() != "b" {
+		t.Errorf("ListAllUserRepos() = %v", got)
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"context"
 	"crypto/rsa"
 	"net/http"
+	"sync"
 
 	"github.com/aereal/merge-chance-time/app/adapter/githubapi"
 	"github.com/bradleyfalzon/ghinstallation"
@@ -13,12 +14,18 @@ import (
 	"golang.org/x/oauth2"
 )
 
+// appTransportKey is the key of the transport of the app itself, which installation IDs never collide with.
+const appTransportKey int64 = 0
+
 func New(appID int64, privKey *rsa.PrivateKey, httpClient *http.Client) GitHubAppsAdapter {
-	return &ghAdapterImpl{
+	a := &ghAdapterImpl{
 		appID:      appID,
 		privKey:    privKey,
 		httpClient: httpClient,
+		transports: map[int64]*rateLimitTransport{},
 	}
+	a.tokens = newTokenCache(a.createInstallationToken)
+	return a
 }
 
 type GitHubAppsAdapter interface {
@@ -31,10 +38,27 @@ type ghAdapterImpl struct {
 	appID      int64
 	privKey    *rsa.PrivateKey
 	httpClient *http.Client
+
+	mux sync.Mutex
+	// transports are kept per installation, because each installation has its own rate limit.
+	transports map[int64]*rateLimitTransport
+	// tokens are shared by the clients of an installation, so that a token is not created on each client.
+	tokens *tokenCache
 }
 
 func (a *ghAdapterImpl) appTransport() *ghinstallation.AppsTransport {
-	return ghinstallation.NewAppsTransportFromPrivateKey(a.httpClient.Transport, a.appID, a.privKey)
+	return ghinstallation.NewAppsTransportFromPrivateKey(a.rateLimitTransport(appTransportKey), a.appID, a.privKey)
+}
+
+func (a *ghAdapterImpl) rateLimitTransport(key int64) *rateLimitTransport {
+	a.mux.Lock()
+	defer a.mux.Unlock()
+	tr, ok := a.transports[key]
+	if !ok {
+		tr = newRateLimitTransport(a.httpClient.Transport)
+		a.transports[key] = tr
+	}
+	return tr
 }
 
 func (a *ghAdapterImpl) NewAppClient() githubapi.Client {
@@ -43,12 +67,23 @@ func (a *ghAdapterImpl) NewAppClient() githubapi.Client {
 }
 
 func (a *ghAdapterImpl) NewInstallationClient(installID int64) githubapi.Client {
-	client := github.NewClient(&http.Client{Transport: ghinstallation.NewFromAppsTransport(a.appTransport(), installID)})
+	tr := &installationTransport{base: a.rateLimitTransport(installID), installID: installID, tokens: a.tokens}
+	client := github.NewClient(&http.Client{Transport: tr})
 	return githubapi.New(client)
 }
 
+func (a *ghAdapterImpl) createInstallationToken(ctx context.Context, installID int64) (*github.InstallationToken, error) {
+	client := github.NewClient(&http.Client{Transport: a.appTransport()})
+	token, _, err := client.Apps.CreateInstallationToken(ctx, installID, nil)
+	if err != nil {
+		return nil, err
+	}
+	return token, nil
+}
+
 func (a *ghAdapterImpl) NewUserClient(ctx context.Context, accessToken string) githubapi.Client {
 	ts := oauth2.StaticTokenSource(&oauth2.Token{AccessToken: accessToken})
-	client := oauth2.NewClient(context.WithValue(ctx, oauth2.HTTPClient, a.httpClient), ts)
+	httpClient := &http.Client{Transport: newRateLimitTransport(a.httpClient.Transport)}
+	client := oauth2.NewClient(context.WithValue(ctx, oauth2.HTTPClient, httpClient), ts)
 	return githubapi.New(github.NewClient(client))
 }
@@ -0,0 +1,118 @@
+package githubapps
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/google/go-github/v30/github"
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+)
+
+const (
+	// defaultRefreshBefore is the margin to refresh a token before its expiry, so that a token is not expired in flight.
+	defaultRefreshBefore = 5 * time.Minute
+	// installationAcceptHeader is the media type of GitHub Apps preview, which ghinstallation sends as well.
+	installationAcceptHeader = "application/vnd.github.machine-man-preview+json"
+)
+
+var (
+	mTokenCacheRequests = stats.Int64("merge-chance-time/installation_token_cache/requests", "Number of installation token lookups", stats.UnitDimensionless)
+
+	// KeyTokenCacheResult is the result of the installation token lookup: hit or miss.
+	KeyTokenCacheResult = tag.MustNewKey("result")
+
+	// TokenCacheRequestCountView counts the installation token lookups by the result.
+	TokenCacheRequestCountView = &view.View{
+		Name:        "merge-chance-time/installation_token_cache/request_count",
+		Description: "Count of installation token lookups by hit or miss",
+		Measure:     mTokenCacheRequests,
+		TagKeys:     []tag.Key{KeyTokenCacheResult},
+		Aggregation: view.Count(),
+	}
+)
+
+type tokenFetcher func(ctx context.Context, installID int64) (*github.InstallationToken, error)
+
+// tokenCache keeps the access tokens of installations until they are about to expire.
+type tokenCache struct {
+	fetch         tokenFetcher
+	refreshBefore time.Duration
+	now           func() time.Time
+
+	mux     sync.Mutex
+	entries map[int64]*cachedToken
+}
+
+type cachedToken struct {
+	// mux is held while the token is refreshed, so that concurrent lookups of an installation fetch only one token.
+	mux       sync.Mutex
+	token     string
+	expiresAt time.Time
+}
+
+func newTokenCache(fetch tokenFetcher) *tokenCache {
+	return &tokenCache{
+		fetch:         fetch,
+		refreshBefore: defaultRefreshBefore,
+		now:           time.Now,
+		entries:       map[int64]*cachedToken{},
+	}
+}
+
+// Token returns the access token of the installation, fetching a new one if no token is cached or the cached one is about to expire.
+func (c *tokenCache) Token(ctx context.Context, installID int64) (string, error) {
+	entry := c.entry(installID)
+	entry.mux.Lock()
+	defer entry.mux.Unlock()
+
+	if entry.token != "" && c.now().Add(c.refreshBefore).Before(entry.expiresAt) {
+		recordTokenCacheResult(ctx, "hit")
+		return entry.token, nil
+	}
+	recordTokenCacheResult(ctx, "miss")
+	token, err := c.fetch(ctx, installID)
+	if err != nil {
+		return "", fmt.Errorf("failed to create an access token of installation %d: %w", installID, err)
+	}
+	entry.token = token.GetToken()
+	entry.expiresAt = token.GetExpiresAt()
+	return entry.token, nil
+}
+
+func (c *tokenCache) entry(installID int64) *cachedToken {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	entry, ok := c.entries[installID]
+	if !ok {
+		entry = &cachedToken{}
+		c.entries[installID] = entry
+	}
+	return entry
+}
+
+func recordTokenCacheResult(ctx context.Context, result string) {
+	_ = stats.RecordWithTags(ctx, []tag.Mutator{tag.Upsert(KeyTokenCacheResult, result)}, mTokenCacheRequests.M(1))
+}
+
+// installationTransport authenticates requests as the installation with the cached access token.
+type installationTransport struct {
+	base      http.RoundTripper
+	installID int64
+	tokens    *tokenCache
+}
+
+func (t *installationTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	token, err := t.tokens.Token(req.Context(), t.installID)
+	if err != nil {
+		return nil, err
+	}
+	r := req.Clone(req.Context())
+	r.Header.Set("Authorization", "token "+token)
+	r.Header.Add("Accept", installationAcceptHeader)
+	return t.base.RoundTrip(r)
+}
@@ -0,0 +1,168 @@
+package githubapps
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v30/github"
+	"go.opencensus.io/stats/view"
+)
+
+func TestTokenCache_Token(t *testing.T) {
+	now := time.Unix(1580000000, 0)
+	tests := []struct {
+		name       string
+		lookups    []time.Duration
+		wantTokens []string
+		wantErr    bool
+	}{
+		{
+			name:       "reused until expiry",
+			lookups:    []time.Duration{0, 30 * time.Minute, 54 * time.Minute},
+			wantTokens: []string{"token-1", "token-1", "token-1"},
+		},
+		{
+			name:       "refreshed before expiry",
+			lookups:    []time.Duration{0, 56 * time.Minute, 57 * time.Minute},
+			wantTokens: []string{"token-1", "token-2", "token-2"},
+		},
+		{
+			name:       "refreshed after expiry",
+			lookups:    []time.Duration{0, 2 * time.Hour},
+			wantTokens: []string{"token-1", "token-2"},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			elapsed := time.Duration(0)
+			fetched := 0
+			c := newTokenCache(func(ctx context.Context, installID int64) (*github.InstallationToken, error) {
+				fetched++
+				expiresAt := now.Add(elapsed).Add(time.Hour)
+				return &github.InstallationToken{Token: github.String(fmt.Sprintf("token-%d", fetched)), ExpiresAt: &expiresAt}, nil
+			})
+			c.now = func() time.Time { return now.Add(elapsed) }
+			for i, d := range tt.lookups {
+				elapsed = d
+				got, err := c.Token(context.Background(), 1234)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if got != tt.wantTokens[i] {
+					t.Errorf("Token() #%d = %q, want %q", i, got, tt.wantTokens[i])
+				}
+			}
+		})
+	}
+}
+
+func TestTokenCache_Token_error(t *testing.T) {
+	fail := true
+	c := newTokenCache(func(ctx context.Context, installID int64) (*github.InstallationToken, error) {
+		if fail {
+			return nil, fmt.Errorf("oops")
+		}
+		expiresAt := time.Now().Add(time.Hour)
+		return &github.InstallationToken{Token: github.String("token"), ExpiresAt: &expiresAt}, nil
+	})
+	if _, err := c.Token(context.Background(), 1234); err == nil {
+		t.Fatal("expected error")
+	}
+	fail = false
+	got, err := c.Token(context.Background(), 1234)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != "token" {
+		t.Errorf("Token() = %q, want %q", got, "token")
+	}
+}
+
+func TestTokenCache_Token_concurrent(t *testing.T) {
+	if err := view.Register(TokenCacheRequestCountView); err != nil {
+		t.Fatal(err)
+	}
+	defer view.Unregister(TokenCacheRequestCountView)
+
+	var (
+		mux     sync.Mutex
+		fetched = map[int64]int{}
+	)
+	c := newTokenCache(func(ctx context.Context, installID int64) (*github.InstallationToken, error) {
+		mux.Lock()
+		fetched[installID]++
+		mux.Unlock()
+		time.Sleep(10 * time.Millisecond)
+		expiresAt := time.Now().Add(time.Hour)
+		return &github.InstallationToken{Token: github.String(fmt.Sprintf("token-%d", installID)), ExpiresAt: &expiresAt}, nil
+	})
+	wg := &sync.WaitGroup{}
+	for i := 0; i < 20; i++ {
+		installID := int64(i%2 + 1)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			got, err := c.Token(context.Background(), installID)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			if want := fmt.Sprintf("token-%d", installID); got != want {
+				t.Errorf("Token() = %q, want %q", got, want)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if fetched[1] != 1 || fetched[2] != 1 {
+		t.Errorf("fetched = %v, want a token per installation", fetched)
+	}
+	rows, err := view.RetrieveData(TokenCacheRequestCountView.Name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	counts := map[string]int64{}
+	for _, row := range rows {
+		for _, tag := range row.Tags {
+			if tag.Key == KeyTokenCacheResult {
+				counts[tag.Value] = row.Data.(*view.CountData).Value
+			}
+		}
+	}
+	if counts["hit"] != 18 || counts["miss"] != 2 {
+		t.Errorf("counts = %v, want 18 hits and 2 misses", counts)
+	}
+}
+
+func TestInstallationTransport(t *testing.T) {
+	var gotAuth string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotAuth = r.Header.Get("Authorization")
+		w.Header().Set("content-type", "application/json")
+		w.Write([]byte(`{}`))
+	}))
+	defer srv.Close()
+
+	c := newTokenCache(func(ctx context.Context, installID int64) (*github.InstallationToken, error) {
+		expiresAt := time.Now().Add(time.Hour)
+		return &github.InstallationToken{Token: github.String("s3cr3t"), ExpiresAt: &expiresAt}, nil
+	})
+	req, _ := http.NewRequest(http.MethodGet, srv.URL, nil)
+	resp, err := (&installationTransport{base: http.DefaultTransport, installID: 1234, tokens: c}).RoundTrip(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if gotAuth != "token s3cr3t" {
+		t.Errorf("Authorization = %q, want %q", gotAuth, "token s3cr3t")
+	}
+	if req.Header.Get("Authorization") != "" {
+		t.Errorf("the original request is modified")
+	}
+}
@@ -0,0 +1,191 @@
+package githubapps
+
+import (
+	"context"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	defaultMaxRetries = 3
+	defaultMinBackoff = 500 * time.Millisecond
+	defaultMaxBackoff = 10 * time.Second
+	// defaultMaxWait bounds the wait for a rate limit reset, so that a request is not blocked until the next hour.
+	defaultMaxWait = time.Minute
+)
+
+// rateLimitTransport retries requests on server errors and rate limited responses.
+//
+// It honors the primary rate limit by waiting until X-RateLimit-Reset once X-RateLimit-Remaining reaches zero,
+// and the secondary (abuse) rate limit by waiting for Retry-After.
+// Server errors are retried with exponential backoff and jitter.
+// A wait longer than maxWait is not done and the response is returned as is.
+type rateLimitTransport struct {
+	base       http.RoundTripper
+	maxRetries int
+	minBackoff time.Duration
+	maxBackoff time.Duration
+	maxWait    time.Duration
+	now        func() time.Time
+	sleep      func(ctx context.Context, d time.Duration) error
+
+	mux sync.Mutex
+	// resetAt is the instant the exhausted primary rate limit is reset, or the zero time if it is not exhausted.
+	resetAt time.Time
+}
+
+func newRateLimitTransport(base http.RoundTripper) *rateLimitTransport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &rateLimitTransport{
+		base:       base,
+		maxRetries: defaultMaxRetries,
+		minBackoff: defaultMinBackoff,
+		maxBackoff: defaultMaxBackoff,
+		maxWait:    defaultMaxWait,
+		now:        time.Now,
+		sleep:      sleepContext,
+	}
+}
+
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	if wait := t.waitForReset(); wait > 0 && wait <= t.maxWait {
+		if err := t.sleep(ctx, wait); err != nil {
+			return nil, err
+		}
+	}
+
+	for attempt := 0; ; attempt++ {
+		r, err := rewind(req, attempt)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := t.base.RoundTrip(r)
+		if err != nil {
+			return nil, err
+		}
+		t.observe(resp)
+		if attempt >= t.maxRetries || (req.Body != nil && req.GetBody == nil) {
+			return resp, nil
+		}
+		wait, retry := t.retryAfter(resp, attempt)
+		if !retry || wait > t.maxWait {
+			return resp, nil
+		}
+		drain(resp)
+		if err := t.sleep(ctx, wait); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// waitForReset returns how long to wait until the exhausted primary rate limit is reset.
+func (t *rateLimitTransport) waitForReset() time.Duration {
+	t.mux.Lock()
+	defer t.mux.Unlock()
+	if t.resetAt.IsZero() {
+		return 0
+	}
+	wait := t.resetAt.Sub(t.now())
+	if wait <= 0 {
+		t.resetAt = time.Time{}
+		return 0
+	}
+	return wait
+}
+
+// observe remembers the primary rate limit state of the response.
+func (t *rateLimitTransport) observe(resp *http.Response) {
+	remaining, resetAt, ok := primaryRateLimit(resp)
+	if !ok {
+		return
+	}
+	t.mux.Lock()
+	defer t.mux.Unlock()
+	if remaining == 0 {
+		t.resetAt = resetAt
+	} else {
+		t.resetAt = time.Time{}
+	}
+}
+
+// retryAfter reports whether the request should be retried after the returned duration.
+func (t *rateLimitTransport) retryAfter(resp *http.Response, attempt int) (time.Duration, bool) {
+	switch {
+	case resp.StatusCode == http.StatusForbidden || resp.StatusCode == http.StatusTooManyRequests:
+		if seconds, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil {
+			return time.Duration(seconds) * time.Second, true
+		}
+		if remaining, resetAt, ok := primaryRateLimit(resp); ok && remaining == 0 {
+			wait := resetAt.Sub(t.now())
+			if wait < 0 {
+				wait = 0
+			}
+			return wait, true
+		}
+		return 0, false
+	case resp.StatusCode >= 500:
+		return t.backoff(attempt), true
+	default:
+		return 0, false
+	}
+}
+
+// backoff returns the exponential backoff of the attempt, jittered between the half and the whole of it.
+func (t *rateLimitTransport) backoff(attempt int) time.Duration {
+	d := t.minBackoff << uint(attempt)
+	if d <= 0 || d > t.maxBackoff {
+		d = t.maxBackoff
+	}
+	return d/2 + time.Duration(rand.Int63n(int64(d/2)+1))
+}
+
+// primaryRateLimit returns the remaining requests and the reset time of the primary rate limit from the headers.
+func primaryRateLimit(resp *http.Response) (int, time.Time, bool) {
+	remaining, err := strconv.Atoi(resp.Header.Get("X-RateLimit-Remaining"))
+	if err != nil {
+		return 0, time.Time{}, false
+	}
+	reset, err := strconv.ParseInt(resp.Header.Get("X-RateLimit-Reset"), 10, 64)
+	if err != nil {
+		return 0, time.Time{}, false
+	}
+	return remaining, time.Unix(reset, 0), true
+}
+
+// rewind returns the request to send on the attempt, with a fresh body on retries.
+func rewind(req *http.Request, attempt int) (*http.Request, error) {
+	if attempt == 0 || req.Body == nil || req.GetBody == nil {
+		return req, nil
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, err
+	}
+	r := req.Clone(req.Context())
+	r.Body = body
+	return r, nil
+}
+
+// drain reads and closes the body of the discarded response, so that the connection can be reused.
+func drain(resp *http.Response) {
+	ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,208 @@
+package githubapps
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v30/github"
+)
+
+// fakeGitHub is a stand-in of the GitHub API responding with the queued responders in order.
+type fakeGitHub struct {
+	mux        sync.Mutex
+	responders []func(w http.ResponseWriter)
+	bodies     []string
+}
+
+func (f *fakeGitHub) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := ioutil.ReadAll(r.Body)
+	f.mux.Lock()
+	defer f.mux.Unlock()
+	f.bodies = append(f.bodies, string(body))
+	if len(f.responders) == 0 {
+		w.WriteHeader(http.StatusTeapot)
+		return
+	}
+	respond := f.responders[0]
+	f.responders = f.responders[1:]
+	respond(w)
+}
+
+func status(code int, headers ...string) func(w http.ResponseWriter) {
+	return func(w http.ResponseWriter) {
+		for i := 0; i+1 < len(headers); i += 2 {
+			w.Header().Set(headers[i], headers[i+1])
+		}
+		w.Header().Set("content-type", "application/json")
+		w.WriteHeader(code)
+		w.Write([]byte(`{}`))
+	}
+}
+
+func TestRateLimitTransport(t *testing.T) {
+	now := time.Unix(1580000000, 0)
+	resetIn := func(d time.Duration) string {
+		return strconv.FormatInt(now.Add(d).Unix(), 10)
+	}
+	tests := []struct {
+		name         string
+		responders   []func(w http.ResponseWriter)
+		requests     int
+		wantRequests int
+		// wantSleeps are exact waits, or jittered backoffs if negative
+		wantSleeps []time.Duration
+		wantStatus int
+	}{
+		{
+			name:         "ok",
+			responders:   []func(w http.ResponseWriter){status(http.StatusCreated)},
+			requests:     1,
+			wantRequests: 1,
+			wantSleeps:   []time.Duration{},
+			wantStatus:   http.StatusCreated,
+		},
+		{
+			name:         "server error",
+			responders:   []func(w http.ResponseWriter){status(http.StatusBadGateway), status(http.StatusServiceUnavailable), status(http.StatusCreated)},
+			requests:     1,
+			wantRequests: 3,
+			wantSleeps:   []time.Duration{-200 * time.Millisecond, -400 * time.Millisecond},
+			wantStatus:   http.StatusCreated,
+		},
+		{
+			name:         "server error persists",
+			responders:   []func(w http.ResponseWriter){status(http.StatusBadGateway), status(http.StatusBadGateway), status(http.StatusBadGateway), status(http.StatusBadGateway)},
+			requests:     1,
+			wantRequests: 4,
+			wantSleeps:   []time.Duration{-200 * time.Millisecond, -400 * time.Millisecond, -800 * time.Millisecond},
+			wantStatus:   http.StatusBadGateway,
+		},
+		{
+			name:         "secondary rate limit",
+			responders:   []func(w http.ResponseWriter){status(http.StatusForbidden, "Retry-After", "3"), status(http.StatusCreated)},
+			requests:     1,
+			wantRequests: 2,
+			wantSleeps:   []time.Duration{3 * time.Second},
+			wantStatus:   http.StatusCreated,
+		},
+		{
+			name:         "secondary rate limit too long",
+			responders:   []func(w http.ResponseWriter){status(http.StatusTooManyRequests, "Retry-After", "3600")},
+			requests:     1,
+			wantRequests: 1,
+			wantSleeps:   []time.Duration{},
+			wantStatus:   http.StatusTooManyRequests,
+		},
+		{
+			name: "primary rate limit exceeded",
+			responders: []func(w http.ResponseWriter){
+				status(http.StatusForbidden, "X-RateLimit-Remaining", "0", "X-RateLimit-Reset", resetIn(30*time.Second)),
+				status(http.StatusCreated, "X-RateLimit-Remaining", "4999", "X-RateLimit-Reset", resetIn(time.Hour)),
+			},
+			requests:     1,
+			wantRequests: 2,
+			wantSleeps:   []time.Duration{30 * time.Second},
+			wantStatus:   http.StatusCreated,
+		},
+		{
+			name: "primary rate limit exhausted",
+			responders: []func(w http.ResponseWriter){
+				status(http.StatusCreated, "X-RateLimit-Remaining", "0", "X-RateLimit-Reset", resetIn(10*time.Second)),
+				status(http.StatusCreated, "X-RateLimit-Remaining", "4999", "X-RateLimit-Reset", resetIn(time.Hour)),
+				status(http.StatusCreated, "X-RateLimit-Remaining", "4998", "X-RateLimit-Reset", resetIn(time.Hour)),
+			},
+			requests:     3,
+			wantRequests: 3,
+			wantSleeps:   []time.Duration{10 * time.Second},
+			wantStatus:   http.StatusCreated,
+		},
+		{
+			name:         "forbidden",
+			responders:   []func(w http.ResponseWriter){status(http.StatusForbidden, "X-RateLimit-Remaining", "4999", "X-RateLimit-Reset", resetIn(time.Hour))},
+			requests:     1,
+			wantRequests: 1,
+			wantSleeps:   []time.Duration{},
+			wantStatus:   http.StatusForbidden,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			gh := &fakeGitHub{responders: tt.responders}
+			srv := httptest.NewServer(gh)
+			defer srv.Close()
+
+			sleeps := []time.Duration{}
+			tr := newRateLimitTransport(http.DefaultTransport)
+			tr.minBackoff = 200 * time.Millisecond
+			tr.now = func() time.Time { return now }
+			tr.sleep = func(ctx context.Context, d time.Duration) error {
+				sleeps = append(sleeps, d)
+				return nil
+			}
+			client := github.NewClient(&http.Client{Transport: tr})
+			client.BaseURL, _ = url.Parse(srv.URL + "/")
+
+			var resp *github.Response
+			for i := 0; i < tt.requests; i++ {
+				_, resp, _ = client.Repositories.CreateStatus(context.Background(), "aereal", "example-repo", "0xdeadbeaf", &github.RepoStatus{State: github.String("success")})
+			}
+			if resp.StatusCode != tt.wantStatus {
+				t.Errorf("status code = %d, want %d", resp.StatusCode, tt.wantStatus)
+			}
+			if len(gh.bodies) != tt.wantRequests {
+				t.Errorf("requests = %d, want %d", len(gh.bodies), tt.wantRequests)
+			}
+			for i, body := range gh.bodies {
+				if body == "" {
+					t.Errorf("body of request #%d is empty", i)
+				}
+			}
+			if len(sleeps) != len(tt.wantSleeps) {
+				t.Fatalf("sleeps = %v, want %v", sleeps, tt.wantSleeps)
+			}
+			for i, want := range tt.wantSleeps {
+				if want < 0 {
+					// jittered backoff between the half and the whole of -want
+					if sleeps[i] < -want/2 || sleeps[i] > -want {
+						t.Errorf("sleeps[%d] = %s, want between %s and %s", i, sleeps[i], -want/2, -want)
+					}
+					continue
+				}
+				if sleeps[i] != want {
+					t.Errorf("sleeps[%d] = %s, want %s", i, sleeps[i], want)
+				}
+			}
+		})
+	}
+}
+
+func TestRateLimitTransport_canceled(t *testing.T) {
+	gh := &fakeGitHub{responders: []func(w http.ResponseWriter){status(http.StatusBadGateway), status(http.StatusCreated)}}
+	srv := httptest.NewServer(gh)
+	defer srv.Close()
+
+	tr := newRateLimitTransport(http.DefaultTransport)
+	tr.minBackoff = time.Hour
+	tr.maxBackoff = time.Hour
+	tr.maxWait = 2 * time.Hour
+	client := github.NewClient(&http.Client{Transport: tr})
+	client.BaseURL, _ = url.Parse(srv.URL + "/")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, _, err := client.Repositories.CreateStatus(ctx, "aereal", "example-repo", "0xdeadbeaf", &github.RepoStatus{State: github.String("success")})
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if len(gh.bodies) != 1 {
+		t.Errorf("requests = %d, want 1", len(gh.bodies))
+	}
+}
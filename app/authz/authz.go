@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/aereal/merge-chance-time/jwtissuer"
 	"gopkg.in/square/go-jose.v2/jwt"
@@ -23,6 +24,8 @@ type Authorizer interface {
 	GetCurrentClaims(ctx context.Context) (*AppClaims, error)
 	Middleware() func(next http.Handler) http.Handler
 	IssueAuthenticationToken(appClaims *AppClaims) (string, error)
+	IssueFeedToken(feedClaims *FeedClaims) (string, error)
+	AuthenticateFeedToken(token string) (*FeedClaims, error)
 }
 
 type authorizerImpl struct {
@@ -40,6 +43,29 @@ type Claims struct {
 
 var _ jwtissuer.ValidatableClaims = Claims{}
 
+// FeedClaims identifies the calendar feed of the repository a feed token grants to read.
+type FeedClaims struct {
+	Owner string
+	Name  string
+	// Generation is the feed generation of the repository config at issuance. The token is revoked once the generation changes.
+	Generation int
+}
+
+type feedTokenClaims struct {
+	jwt.Claims
+	*FeedClaims
+}
+
+var _ jwtissuer.ValidatableClaims = feedTokenClaims{}
+
+var (
+	// feedTokenSubject distinguishes feed tokens from other signed tokens.
+	feedTokenSubject = "feed"
+	// feedTokenLifetime is long enough for calendar apps to keep subscribing the feed.
+	// Tokens are revoked before expiry by incrementing the feed generation of the repository config.
+	feedTokenLifetime = time.Hour * 24 * 365
+)
+
 type keyType struct{}
 
 var ctxKeyAppClaims = &keyType{}
@@ -93,3 +119,25 @@ func (a *authorizerImpl) IssueAuthenticationToken(appClaims *AppClaims) (string,
 	}
 	return token, nil
 }
+
+func (a *authorizerImpl) IssueFeedToken(feedClaims *FeedClaims) (string, error) {
+	stdClaims := jwtissuer.NewStandardClaims()
+	stdClaims.Subject = feedTokenSubject
+	stdClaims.Expiry = jwt.NewNumericDate(stdClaims.IssuedAt.Time().Add(feedTokenLifetime))
+	claims := feedTokenClaims{
+		stdClaims,
+		feedClaims,
+	}
+	return a.issuer.Signed(claims)
+}
+
+func (a *authorizerImpl) AuthenticateFeedToken(token string) (*FeedClaims, error) {
+	var out feedTokenClaims
+	if err := a.issuer.ParseSigned(token, &out); err != nil {
+		return nil, err
+	}
+	if out.Subject != feedTokenSubject || out.FeedClaims == nil {
+		return nil, fmt.Errorf("not a feed token")
+	}
+	return out.FeedClaims, nil
+}
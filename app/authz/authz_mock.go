@@ -34,6 +34,21 @@ func (m *MockAuthorizer) EXPECT() *MockAuthorizerMockRecorder {
 	return m.recorder
 }
 
+// AuthenticateFeedToken mocks base method
+func (m *MockAuthorizer) AuthenticateFeedToken(arg0 string) (*FeedClaims, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthenticateFeedToken", arg0)
+	ret0, _ := ret[0].(*FeedClaims)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AuthenticateFeedToken indicates an expected call of AuthenticateFeedToken
+func (mr *MockAuthorizerMockRecorder) AuthenticateFeedToken(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthenticateFeedToken", reflect.TypeOf((*MockAuthorizer)(nil).AuthenticateFeedToken), arg0)
+}
+
 // GetCurrentClaims mocks base method
 func (m *MockAuthorizer) GetCurrentClaims(arg0 context.Context) (*AppClaims, error) {
 	m.ctrl.T.Helper()
@@ -64,6 +79,21 @@ func (mr *MockAuthorizerMockRecorder) IssueAuthenticationToken(arg0 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IssueAuthenticationToken", reflect.TypeOf((*MockAuthorizer)(nil).IssueAuthenticationToken), arg0)
 }
 
+// IssueFeedToken mocks base method
+func (m *MockAuthorizer) IssueFeedToken(arg0 *FeedClaims) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IssueFeedToken", arg0)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IssueFeedToken indicates an expected call of IssueFeedToken
+func (mr *MockAuthorizerMockRecorder) IssueFeedToken(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IssueFeedToken", reflect.TypeOf((*MockAuthorizer)(nil).IssueFeedToken), arg0)
+}
+
 // Middleware mocks base method
 func (m *MockAuthorizer) Middleware() func(http.Handler) http.Handler {
 	m.ctrl.T.Helper()
@@ -5,6 +5,8 @@ import (
 	"net/url"
 	"os"
 	"strconv"
+
+	"github.com/aereal/merge-chance-time/domain/model"
 )
 
 var (
@@ -15,11 +17,17 @@ var (
 	keyClientID      = "GH_APP_CLIENT_ID"
 	keyClientSecret  = "GH_APP_CLIENT_SECRET"
 	keyAdminOrigin   = "ADMIN_ORIGIN"
+	// keyUseCheckRun makes the app report merge chances as check runs instead of commit statuses.
+	// The app must be granted the checks:write permission in addition to statuses:write.
+	// Commit statuses reported before are left as is, so pending ones keep blocking the pull requests until their heads are updated.
+	keyUseCheckRun = "GH_USE_CHECK_RUN"
+	// keyMinConfigPermission is the permission on a repository required to update its config (e.g. "maintain"). Defaults to admin.
+	keyMinConfigPermission = "GH_MIN_CONFIG_PERMISSION"
 )
 
 func NewFromEnvironment() (*Config, error) {
 	cfg := &Config{GitHubAppConfig: &GitHubAppConfig{}}
-	envs := getEnvs(keyPort, keyGCPProjectID, keyAppID, keyWebhookSecret, keyClientID, keyClientSecret, keyAdminOrigin)
+	envs := getEnvs(keyPort, keyGCPProjectID, keyAppID, keyWebhookSecret, keyClientID, keyClientSecret, keyAdminOrigin, keyUseCheckRun, keyMinConfigPermission)
 
 	cfg.ListenPort = envs[keyPort]
 	if cfg.ListenPort == "" {
@@ -57,6 +65,21 @@ func NewFromEnvironment() (*Config, error) {
 		return nil, fmt.Errorf("%s is invalid: %w", keyAppID, err)
 	}
 	cfg.GitHubAppConfig.ID = int64(appID)
+	if v := envs[keyUseCheckRun]; v != "" {
+		useCheckRun, err := strconv.ParseBool(v)
+		if err != nil {
+			return nil, fmt.Errorf("%s is invalid: %w", keyUseCheckRun, err)
+		}
+		cfg.GitHubAppConfig.UseCheckRun = useCheckRun
+	}
+	cfg.GitHubAppConfig.MinConfigPermission = model.PermissionAdmin
+	if v := envs[keyMinConfigPermission]; v != "" {
+		perm, err := model.ParsePermission(v)
+		if err != nil {
+			return nil, fmt.Errorf("%s is invalid: %w", keyMinConfigPermission, err)
+		}
+		cfg.GitHubAppConfig.MinConfigPermission = perm
+	}
 
 	return cfg, nil
 }
@@ -73,6 +96,10 @@ type GitHubAppConfig struct {
 	WebhookSecret []byte
 	ClientID      string
 	ClientSecret  string
+	// UseCheckRun is true if merge chances are reported as check runs instead of commit statuses.
+	UseCheckRun bool
+	// MinConfigPermission is the permission on a repository required to update its config.
+	MinConfigPermission model.Permission
 }
 
 func getEnvs(names ...string) map[string]string {
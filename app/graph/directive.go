@@ -0,0 +1,37 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/aereal/merge-chance-time/app/graph/dto"
+	"github.com/aereal/merge-chance-time/app/graph/generated"
+)
+
+// Directives returns the implementations of the directives declared in the schema.
+func (r *Resolver) Directives() generated.DirectiveRoot {
+	return generated.DirectiveRoot{
+		RepositoryAccess: r.repositoryAccess,
+	}
+}
+
+// repositoryAccess resolves the field of the repository only if the current user can see it.
+func (r *Resolver) repositoryAccess(ctx context.Context, obj interface{}, next graphql.Resolver) (interface{}, error) {
+	repository, ok := obj.(*dto.Repository)
+	if !ok {
+		return nil, fmt.Errorf("@repositoryAccess is not applicable to %T", obj)
+	}
+	if repository.Owner == nil {
+		return nil, newError(ErrorCodeNotFound, "repository %s is not found", repository.FullName)
+	}
+	claims, err := r.authorizer.GetCurrentClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+	client := r.ghAdapter.NewUserClient(ctx, claims.AccessToken)
+	if _, err := visibleRepository(ctx, client, repository.Owner.GetLogin(), repository.Name); err != nil {
+		return nil, err
+	}
+	return next(ctx)
+}
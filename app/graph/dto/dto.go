@@ -1,6 +1,11 @@
 package dto
 
 import (
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
 	"github.com/aereal/merge-chance-time/domain/model"
 	"github.com/google/go-github/v30/github"
 )
@@ -64,96 +69,313 @@ func NewRepositoryFromResponse(r *github.Repository) *Repository {
 	}
 }
 
-func NewMergeChanceSchedules(m *model.MergeChanceSchedules) *MergeChanceSchedules {
-	d := &MergeChanceSchedules{}
-	if m.Sunday != nil {
-		d.Sunday = &MergeChanceSchedule{
-			StartHour: m.Sunday.StartHour,
-			StopHour:  m.Sunday.StopHour,
-		}
+func NewRepositoryConfig(m *model.RepositoryConfig) *RepositoryConfig {
+	d := &RepositoryConfig{
+		MergeAvailable:     m.MergeAvailable,
+		Schedules:          NewMergeChanceSchedules(m.Schedules),
+		ExceptionDates:     NewExceptionDates(m.Calendar),
+		FreezePeriods:      NewFreezePeriods(m.Calendar),
+		Override:           NewMergeOverride(m.Override, time.Now()),
+		BaseBranchPatterns: []string{},
+		StatusContext:      m.StatusContextName(),
+		OpenDescription:    m.StatusDescription(true),
+		ClosedDescription:  m.StatusDescription(false),
 	}
-	if m.Monday != nil {
-		d.Monday = &MergeChanceSchedule{
-			StartHour: m.Monday.StartHour,
-			StopHour:  m.Monday.StopHour,
-		}
+	if m.BaseBranchPatterns != nil {
+		d.BaseBranchPatterns = m.BaseBranchPatterns
 	}
-	if m.Tuesday != nil {
-		d.Tuesday = &MergeChanceSchedule{
-			StartHour: m.Tuesday.StartHour,
-			StopHour:  m.Tuesday.StopHour,
-		}
+	d.BranchRules = NewBranchRules(m.BranchRules)
+	if m.TimeZone != "" {
+		tz := m.TimeZone
+		d.TimeZone = &tz
 	}
-	if m.Wednesday != nil {
-		d.Wednesday = &MergeChanceSchedule{
-			StartHour: m.Wednesday.StartHour,
-			StopHour:  m.Wednesday.StopHour,
-		}
+	if m.BypassLabel != "" {
+		label := m.BypassLabel
+		d.BypassLabel = &label
 	}
-	if m.Thursday != nil {
-		d.Thursday = &MergeChanceSchedule{
-			StartHour: m.Thursday.StartHour,
-			StopHour:  m.Thursday.StopHour,
-		}
+	return d
+}
+
+func NewExceptionDates(m *model.Calendar) []*ExceptionDate {
+	dates := []*ExceptionDate{}
+	if m == nil {
+		return dates
 	}
-	if m.Friday != nil {
-		d.Friday = &MergeChanceSchedule{
-			StartHour: m.Friday.StartHour,
-			StopHour:  m.Friday.StopHour,
-		}
+	for _, d := range m.ExceptionDates {
+		dates = append(dates, &ExceptionDate{
+			Date:   d.Date.String(),
+			Reason: d.Reason,
+		})
+	}
+	return dates
+}
+
+func NewFreezePeriods(m *model.Calendar) []*FreezePeriod {
+	periods := []*FreezePeriod{}
+	if m == nil {
+		return periods
+	}
+	for _, p := range m.FreezePeriods {
+		periods = append(periods, &FreezePeriod{
+			Start:   p.Start,
+			End:     p.End,
+			Summary: p.Summary,
+		})
+	}
+	return periods
+}
+
+// NewMergeOverride returns nil if the override is not in effect at now.
+func NewMergeOverride(m *model.Override, now time.Time) *MergeOverride {
+	if !m.ActiveAt(now) {
+		return nil
+	}
+	return &MergeOverride{
+		State:     NewMergeState(m.MergeAvailable),
+		Until:     m.Until,
+		CreatedBy: m.CreatedBy,
+		CreatedAt: m.CreatedAt,
+	}
+}
+
+func NewMergeState(mergeAvailable bool) MergeState {
+	if mergeAvailable {
+		return MergeStateOpen
+	}
+	return MergeStateClosed
+}
+
+func (s MergeState) ToModel() bool {
+	return s == MergeStateOpen
+}
+
+func NewBranchRules(rules []*model.BranchRule) []*BranchRule {
+	dtos := []*BranchRule{}
+	for _, rule := range rules {
+		dtos = append(dtos, &BranchRule{
+			Pattern:        rule.Pattern,
+			Schedules:      NewMergeChanceSchedules(rule.Schedules),
+			MergeAvailable: rule.MergeAvailable,
+		})
+	}
+	return dtos
+}
+
+// ToModel returns the branch rule to replace current, which is nil if the rule is new. See MergeChanceSchedulesToUpdate.ToModel for the errors.
+func (r *BranchRuleToUpdate) ToModel(field string, current *model.BranchRule) (*model.BranchRule, error) {
+	var currentSchedules *model.MergeChanceSchedules
+	if current != nil {
+		currentSchedules = current.Schedules
+	}
+	schedules, err := r.Schedules.ToModel(field+".schedules", currentSchedules)
+	if err != nil {
+		return nil, err
+	}
+	return &model.BranchRule{
+		Pattern:   r.Pattern,
+		Schedules: schedules,
+	}, nil
+}
+
+// NewAuditEventConnection returns the page of the audit events. The cursor of an event is its ID.
+func NewAuditEventConnection(events []*model.AuditEvent, hasNextPage bool) *AuditEventConnection {
+	conn := &AuditEventConnection{
+		Edges:    []*AuditEventEdge{},
+		Nodes:    []*AuditEvent{},
+		PageInfo: &PageInfo{HasNextPage: hasNextPage},
+	}
+	for _, e := range events {
+		node := NewAuditEvent(e)
+		conn.Edges = append(conn.Edges, &AuditEventEdge{Cursor: e.ID, Node: node})
+		conn.Nodes = append(conn.Nodes, node)
+	}
+	if len(events) > 0 {
+		cursor := events[len(events)-1].ID
+		conn.PageInfo.EndCursor = &cursor
+	}
+	return conn
+}
+
+func NewAuditEvent(m *model.AuditEvent) *AuditEvent {
+	d := &AuditEvent{
+		ID:          m.ID,
+		Type:        AuditEventType(m.Type),
+		Description: m.Description,
+		CreatedAt:   m.CreatedAt,
 	}
-	if m.Saturday != nil {
-		d.Saturday = &MergeChanceSchedule{
-			StartHour: m.Saturday.StartHour,
-			StopHour:  m.Saturday.StopHour,
+	if m.Actor != "" {
+		actor := m.Actor
+		d.Actor = &actor
+	}
+	if m.BranchPattern != "" {
+		pattern := m.BranchPattern
+		d.BranchPattern = &pattern
+	}
+	return d
+}
+
+func NewCalendarFeed(owner, name, token string) *CalendarFeed {
+	path := fmt.Sprintf("/feeds/%s/%s/merge-chances.ics?token=%s", url.PathEscape(owner), url.PathEscape(name), url.QueryEscape(token))
+	return &CalendarFeed{Token: token, Path: path}
+}
+
+func NewMergeChanceSchedules(m *model.MergeChanceSchedules) *MergeChanceSchedules {
+	d := &MergeChanceSchedules{
+		Sunday:    firstMergeChanceSchedule(m.Sunday),
+		Monday:    firstMergeChanceSchedule(m.Monday),
+		Tuesday:   firstMergeChanceSchedule(m.Tuesday),
+		Wednesday: firstMergeChanceSchedule(m.Wednesday),
+		Thursday:  firstMergeChanceSchedule(m.Thursday),
+		Friday:    firstMergeChanceSchedule(m.Friday),
+		Saturday:  firstMergeChanceSchedule(m.Saturday),
+		Windows:   []*MergeChanceWindow{},
+	}
+	for _, wd := range AllWeekday {
+		for _, s := range m.ForWeekday(wd.ToModel()) {
+			d.Windows = append(d.Windows, &MergeChanceWindow{
+				Weekday:      wd,
+				StartHour:    s.StartHour,
+				StartMinute:  s.StartMinute,
+				StopHour:     s.StopHour,
+				StopMinute:   s.StopMinute,
+				StopsNextDay: s.StopsNextDay,
+			})
 		}
 	}
 	return d
 }
 
-func (d *MergeChanceSchedulesToUpdate) ToModel() *model.MergeChanceSchedules {
+func firstMergeChanceSchedule(schedules []*model.MergeChanceSchedule) *MergeChanceSchedule {
+	if len(schedules) == 0 {
+		return nil
+	}
+	return NewMergeChanceSchedule(schedules[0])
+}
+
+func NewMergeChanceSchedule(m *model.MergeChanceSchedule) *MergeChanceSchedule {
+	if m == nil {
+		return nil
+	}
+	return &MergeChanceSchedule{
+		StartHour:    m.StartHour,
+		StartMinute:  m.StartMinute,
+		StopHour:     m.StopHour,
+		StopMinute:   m.StopMinute,
+		StopsNextDay: m.StopsNextDay,
+	}
+}
+
+// ToModel returns the schedules to replace current at the field.
+// The weekday fields carry only one window of each weekday, so it returns *model.ValidationError
+// instead of dropping the windows of current if a weekday field would lose the other windows or the fields it omits.
+func (d *MergeChanceSchedulesToUpdate) ToModel(field string, current *model.MergeChanceSchedules) (*model.MergeChanceSchedules, error) {
 	m := &model.MergeChanceSchedules{}
-	if d.Sunday != nil {
-		m.Sunday = &model.MergeChanceSchedule{
-			StartHour: d.Sunday.StartHour,
-			StopHour:  d.Sunday.StopHour,
+	if d.Windows != nil {
+		for _, w := range d.Windows {
+			m.Add(w.Weekday.ToModel(), w.ToModel())
 		}
+		return m, nil
 	}
-	if d.Monday != nil {
-		m.Monday = &model.MergeChanceSchedule{
-			StartHour: d.Monday.StartHour,
-			StopHour:  d.Monday.StopHour,
-		}
+	days := map[Weekday]*MergeChanceScheduleToUpdate{
+		WeekdaySunday:    d.Sunday,
+		WeekdayMonday:    d.Monday,
+		WeekdayTuesday:   d.Tuesday,
+		WeekdayWednesday: d.Wednesday,
+		WeekdayThursday:  d.Thursday,
+		WeekdayFriday:    d.Friday,
+		WeekdaySaturday:  d.Saturday,
 	}
-	if d.Tuesday != nil {
-		m.Tuesday = &model.MergeChanceSchedule{
-			StartHour: d.Tuesday.StartHour,
-			StopHour:  d.Tuesday.StopHour,
+	errs := []*model.FieldError{}
+	for _, wd := range AllWeekday {
+		s := days[wd]
+		if s == nil {
+			continue
 		}
+		schedule := s.ToModel()
+		errs = append(errs, s.lostFields(field+"."+strings.ToLower(wd.String()), schedule, current.ForWeekday(wd.ToModel()))...)
+		m.Add(wd.ToModel(), schedule)
 	}
-	if d.Wednesday != nil {
-		m.Wednesday = &model.MergeChanceSchedule{
-			StartHour: d.Wednesday.StartHour,
-			StopHour:  d.Wednesday.StopHour,
-		}
+	if len(errs) > 0 {
+		return nil, &model.ValidationError{Errors: errs}
 	}
-	if d.Thursday != nil {
-		m.Thursday = &model.MergeChanceSchedule{
-			StartHour: d.Thursday.StartHour,
-			StopHour:  d.Thursday.StopHour,
-		}
+	return m, nil
+}
+
+// lostFields returns the violations at the field if writing schedule over current of the weekday loses the windows after the first,
+// or the values of the fields d omits.
+func (d *MergeChanceScheduleToUpdate) lostFields(field string, schedule *model.MergeChanceSchedule, current []*model.MergeChanceSchedule) []*model.FieldError {
+	if len(current) == 0 {
+		return nil
 	}
-	if d.Friday != nil {
-		m.Friday = &model.MergeChanceSchedule{
-			StartHour: d.Friday.StartHour,
-			StopHour:  d.Friday.StopHour,
-		}
+	if len(current) > 1 {
+		return []*model.FieldError{{Field: field, Message: fmt.Sprintf("cannot replace %d windows; update them with windows", len(current))}}
 	}
-	if d.Saturday != nil {
-		m.Saturday = &model.MergeChanceSchedule{
-			StartHour: d.Saturday.StartHour,
-			StopHour:  d.Saturday.StopHour,
+	errs := []*model.FieldError{}
+	for _, f := range []struct {
+		name    string
+		omitted bool
+		value   interface{}
+		current interface{}
+	}{
+		{"startMinute", d.StartMinute == nil, schedule.StartMinute, current[0].StartMinute},
+		{"stopMinute", d.StopMinute == nil, schedule.StopMinute, current[0].StopMinute},
+		{"stopsNextDay", d.StopsNextDay == nil, schedule.StopsNextDay, current[0].StopsNextDay},
+	} {
+		if f.omitted && f.value != f.current {
+			errs = append(errs, &model.FieldError{Field: field + "." + f.name, Message: fmt.Sprintf("must be given to keep the current value %v", f.current)})
 		}
 	}
+	return errs
+}
+
+func (d *MergeChanceScheduleToUpdate) ToModel() *model.MergeChanceSchedule {
+	if d == nil {
+		return nil
+	}
+	m := newMergeChanceScheduleModel(d.StartHour, d.StartMinute, d.StopHour, d.StopMinute, d.StopsNextDay)
+	// the clients which know only the hours send back the whole day (00:00 to 00:00 on the next day) as it is read, without stopsNextDay
+	if d.StopsNextDay == nil && m.StartHour == m.StopHour && m.StartMinute == m.StopMinute {
+		m.StopsNextDay = true
+	}
 	return m
 }
+
+func (d *MergeChanceWindowToUpdate) ToModel() *model.MergeChanceSchedule {
+	return newMergeChanceScheduleModel(d.StartHour, d.StartMinute, d.StopHour, d.StopMinute, d.StopsNextDay)
+}
+
+func newMergeChanceScheduleModel(startHour int, startMinute *int, stopHour int, stopMinute *int, stopsNextDay *bool) *model.MergeChanceSchedule {
+	m := &model.MergeChanceSchedule{
+		StartHour: startHour,
+		StopHour:  stopHour,
+	}
+	if startMinute != nil {
+		m.StartMinute = *startMinute
+	}
+	if stopMinute != nil {
+		m.StopMinute = *stopMinute
+	}
+	if stopsNextDay != nil {
+		m.StopsNextDay = *stopsNextDay
+	}
+	return m
+}
+
+func (e Weekday) ToModel() time.Weekday {
+	switch e {
+	case WeekdaySunday:
+		return time.Sunday
+	case WeekdayMonday:
+		return time.Monday
+	case WeekdayTuesday:
+		return time.Tuesday
+	case WeekdayWednesday:
+		return time.Wednesday
+	case WeekdayThursday:
+		return time.Thursday
+	case WeekdayFriday:
+		return time.Friday
+	default:
+		return time.Saturday
+	}
+}
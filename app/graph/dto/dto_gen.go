@@ -2,14 +2,85 @@
 
 package dto
 
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+)
+
+type AuditEvent struct {
+	ID   string         `json:"id"`
+	Type AuditEventType `json:"type"`
+	// Login of the user who made the change. null if the change is made automatically.
+	Actor *string `json:"actor"`
+	// Pattern of the branch rule whose merge chance changed. null for the repository itself.
+	BranchPattern *string `json:"branchPattern"`
+	// Details of the change (e.g. the changed fields of the config)
+	Description string    `json:"description"`
+	CreatedAt   time.Time `json:"createdAt"`
+}
+
+type AuditEventConnection struct {
+	Edges    []*AuditEventEdge `json:"edges"`
+	Nodes    []*AuditEvent     `json:"nodes"`
+	PageInfo *PageInfo         `json:"pageInfo"`
+}
+
+type AuditEventEdge struct {
+	Cursor string      `json:"cursor"`
+	Node   *AuditEvent `json:"node"`
+}
+
+type BranchRule struct {
+	// Glob pattern of base branches (e.g. "release/*")
+	Pattern        string                `json:"pattern"`
+	Schedules      *MergeChanceSchedules `json:"schedules"`
+	MergeAvailable bool                  `json:"mergeAvailable"`
+}
+
+type BranchRuleToUpdate struct {
+	Pattern   string                        `json:"pattern"`
+	Schedules *MergeChanceSchedulesToUpdate `json:"schedules"`
+}
+
+type CalendarFeed struct {
+	Token string `json:"token"`
+	// Path of the feed including the token, relative to the origin of the API
+	Path string `json:"path"`
+}
+
+type ExceptionDate struct {
+	// Formatted as YYYY-MM-DD
+	Date   string `json:"date"`
+	Reason string `json:"reason"`
+}
+
+type FreezePeriod struct {
+	Start   time.Time `json:"start"`
+	End     time.Time `json:"end"`
+	Summary string    `json:"summary"`
+}
+
 type MergeChanceSchedule struct {
-	StartHour int `json:"startHour"`
-	StopHour  int `json:"stopHour"`
+	StartHour   int `json:"startHour"`
+	StartMinute int `json:"startMinute"`
+	StopHour    int `json:"stopHour"`
+	StopMinute  int `json:"stopMinute"`
+	// Whether the window stops on the day after it starts
+	StopsNextDay bool `json:"stopsNextDay"`
 }
 
 type MergeChanceScheduleToUpdate struct {
 	StartHour int `json:"startHour"`
-	StopHour  int `json:"stopHour"`
+	// Defaults to 0
+	StartMinute *int `json:"startMinute"`
+	StopHour    int  `json:"stopHour"`
+	// Defaults to 0
+	StopMinute *int `json:"stopMinute"`
+	// Whether the window stops on the day after it starts (e.g. 22:00 on Friday to 02:00 on Saturday).
+	// Defaults to true if the window starts and stops at the same time, so that the whole day read without this field is written back as it is, and false otherwise
+	StopsNextDay *bool `json:"stopsNextDay"`
 }
 
 type MergeChanceSchedules struct {
@@ -20,8 +91,11 @@ type MergeChanceSchedules struct {
 	Thursday  *MergeChanceSchedule `json:"thursday"`
 	Friday    *MergeChanceSchedule `json:"friday"`
 	Saturday  *MergeChanceSchedule `json:"saturday"`
+	Windows   []*MergeChanceWindow `json:"windows"`
 }
 
+// The weekday fields replace the only window of each weekday.
+// They are rejected if the weekday has more than one window, or if they omit a field the current window sets, so use windows to update such weekdays.
 type MergeChanceSchedulesToUpdate struct {
 	Sunday    *MergeChanceScheduleToUpdate `json:"sunday"`
 	Monday    *MergeChanceScheduleToUpdate `json:"monday"`
@@ -30,13 +104,226 @@ type MergeChanceSchedulesToUpdate struct {
 	Thursday  *MergeChanceScheduleToUpdate `json:"thursday"`
 	Friday    *MergeChanceScheduleToUpdate `json:"friday"`
 	Saturday  *MergeChanceScheduleToUpdate `json:"saturday"`
+	// All windows of the week. The fields of each weekday are ignored if given.
+	Windows []*MergeChanceWindowToUpdate `json:"windows"`
+}
+
+type MergeChanceWindow struct {
+	Weekday     Weekday `json:"weekday"`
+	StartHour   int     `json:"startHour"`
+	StartMinute int     `json:"startMinute"`
+	StopHour    int     `json:"stopHour"`
+	StopMinute  int     `json:"stopMinute"`
+	// Whether the window stops on the day after it starts
+	StopsNextDay bool `json:"stopsNextDay"`
+}
+
+type MergeChanceWindowToUpdate struct {
+	Weekday   Weekday `json:"weekday"`
+	StartHour int     `json:"startHour"`
+	// Defaults to 0
+	StartMinute *int `json:"startMinute"`
+	StopHour    int  `json:"stopHour"`
+	// Defaults to 0
+	StopMinute *int `json:"stopMinute"`
+	// Whether the window stops on the day after it starts (e.g. 22:00 on Friday to 02:00 on Saturday). Defaults to false
+	StopsNextDay *bool `json:"stopsNextDay"`
+}
+
+type MergeOverride struct {
+	State MergeState `json:"state"`
+	Until time.Time  `json:"until"`
+	// Login of the user who set the override
+	CreatedBy string    `json:"createdBy"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+type PageInfo struct {
+	HasNextPage bool    `json:"hasNextPage"`
+	EndCursor   *string `json:"endCursor"`
 }
 
 type RepositoryConfig struct {
 	Schedules      *MergeChanceSchedules `json:"schedules"`
 	MergeAvailable bool                  `json:"mergeAvailable"`
+	// IANA time zone name the schedules are evaluated in. null means the time zone of the server.
+	TimeZone *string `json:"timeZone"`
+	// Dates on which merges on the repository are blocked regardless of the schedules.
+	ExceptionDates []*ExceptionDate `json:"exceptionDates"`
+	// Periods in which merges on the repository are blocked regardless of the schedules.
+	FreezePeriods []*FreezePeriod `json:"freezePeriods"`
+	// The override in effect. null if there is none or it has expired.
+	Override *MergeOverride `json:"override"`
+	// Pull requests labeled with it are mergeable even while merges are unavailable. null means bypassing is disabled.
+	BypassLabel *string `json:"bypassLabel"`
+	// Glob patterns of the base branches of gated pull requests (e.g. "release/*"). Pull requests into any branch are gated if empty.
+	BaseBranchPatterns []string `json:"baseBranchPatterns"`
+	// Rules evaluated in order. The first rule matching the base branch of a pull request governs it instead of the schedules of the repository.
+	BranchRules []*BranchRule `json:"branchRules"`
+	// Name of the status context (or the check run) reporting the merge chance on pull requests. Branch protection should require it.
+	StatusContext string `json:"statusContext"`
+	// Description of the status of mergeable pull requests.
+	OpenDescription string `json:"openDescription"`
+	// Description of the status of unmergeable pull requests.
+	ClosedDescription string `json:"closedDescription"`
 }
 
 type RepositoryConfigToUpdate struct {
 	Schedules *MergeChanceSchedulesToUpdate `json:"schedules"`
+	// IANA time zone name (e.g. "Asia/Tokyo"). The current time zone is kept if omitted.
+	TimeZone *string `json:"timeZone"`
+	// Name of the label bypassing closed merges (e.g. "hotfix"). The current label is kept if omitted, and an empty string disables bypassing.
+	BypassLabel *string `json:"bypassLabel"`
+	// Glob patterns of the base branches of gated pull requests. The current patterns are kept if omitted.
+	BaseBranchPatterns []string `json:"baseBranchPatterns"`
+	// Replaces the branch rules in the given order. The current rules are kept if omitted.
+	BranchRules []*BranchRuleToUpdate `json:"branchRules"`
+	// Name of the status context. The statuses of open pull requests are moved to the new context when it changes.
+	// The current context is kept if omitted, and an empty string resets it to the default.
+	StatusContext *string `json:"statusContext"`
+	// Description of the status of mergeable pull requests. The current description is kept if omitted, and an empty string resets it to the default.
+	OpenDescription *string `json:"openDescription"`
+	// Description of the status of unmergeable pull requests. The current description is kept if omitted, and an empty string resets it to the default.
+	ClosedDescription *string `json:"closedDescription"`
+}
+
+type AuditEventType string
+
+const (
+	AuditEventTypeConfigUpdated     AuditEventType = "CONFIG_UPDATED"
+	AuditEventTypeMergeOpened       AuditEventType = "MERGE_OPENED"
+	AuditEventTypeMergeClosed       AuditEventType = "MERGE_CLOSED"
+	AuditEventTypeOverrideSet       AuditEventType = "OVERRIDE_SET"
+	AuditEventTypeOverrideExpired   AuditEventType = "OVERRIDE_EXPIRED"
+	AuditEventTypeOverrideRequested AuditEventType = "OVERRIDE_REQUESTED"
+)
+
+var AllAuditEventType = []AuditEventType{
+	AuditEventTypeConfigUpdated,
+	AuditEventTypeMergeOpened,
+	AuditEventTypeMergeClosed,
+	AuditEventTypeOverrideSet,
+	AuditEventTypeOverrideExpired,
+	AuditEventTypeOverrideRequested,
+}
+
+func (e AuditEventType) IsValid() bool {
+	switch e {
+	case AuditEventTypeConfigUpdated, AuditEventTypeMergeOpened, AuditEventTypeMergeClosed, AuditEventTypeOverrideSet, AuditEventTypeOverrideExpired, AuditEventTypeOverrideRequested:
+		return true
+	}
+	return false
+}
+
+func (e AuditEventType) String() string {
+	return string(e)
+}
+
+func (e *AuditEventType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = AuditEventType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid AuditEventType", str)
+	}
+	return nil
+}
+
+func (e AuditEventType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type MergeState string
+
+const (
+	MergeStateOpen   MergeState = "OPEN"
+	MergeStateClosed MergeState = "CLOSED"
+)
+
+var AllMergeState = []MergeState{
+	MergeStateOpen,
+	MergeStateClosed,
+}
+
+func (e MergeState) IsValid() bool {
+	switch e {
+	case MergeStateOpen, MergeStateClosed:
+		return true
+	}
+	return false
+}
+
+func (e MergeState) String() string {
+	return string(e)
+}
+
+func (e *MergeState) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = MergeState(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid MergeState", str)
+	}
+	return nil
+}
+
+func (e MergeState) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type Weekday string
+
+const (
+	WeekdaySunday    Weekday = "SUNDAY"
+	WeekdayMonday    Weekday = "MONDAY"
+	WeekdayTuesday   Weekday = "TUESDAY"
+	WeekdayWednesday Weekday = "WEDNESDAY"
+	WeekdayThursday  Weekday = "THURSDAY"
+	WeekdayFriday    Weekday = "FRIDAY"
+	WeekdaySaturday  Weekday = "SATURDAY"
+)
+
+var AllWeekday = []Weekday{
+	WeekdaySunday,
+	WeekdayMonday,
+	WeekdayTuesday,
+	WeekdayWednesday,
+	WeekdayThursday,
+	WeekdayFriday,
+	WeekdaySaturday,
+}
+
+func (e Weekday) IsValid() bool {
+	switch e {
+	case WeekdaySunday, WeekdayMonday, WeekdayTuesday, WeekdayWednesday, WeekdayThursday, WeekdayFriday, WeekdaySaturday:
+		return true
+	}
+	return false
+}
+
+func (e Weekday) String() string {
+	return string(e)
+}
+
+func (e *Weekday) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = Weekday(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid Weekday", str)
+	}
+	return nil
+}
+
+func (e Weekday) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
 }
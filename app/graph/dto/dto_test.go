@@ -0,0 +1,121 @@
+package dto
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/aereal/merge-chance-time/domain/model"
+)
+
+func TestMergeChanceSchedulesToUpdate_ToModel(t *testing.T) {
+	minutes := func(m int) *int { return &m }
+	stopsNextDay := func(b bool) *bool { return &b }
+	tests := []struct {
+		name    string
+		dto     *MergeChanceSchedulesToUpdate
+		current *model.MergeChanceSchedules
+		want    *model.MergeChanceSchedules
+		wantErr []*model.FieldError
+	}{
+		{
+			name: "weekday fields",
+			dto: &MergeChanceSchedulesToUpdate{
+				Monday: &MergeChanceScheduleToUpdate{StartHour: 9, StartMinute: minutes(30), StopHour: 18},
+				Friday: &MergeChanceScheduleToUpdate{StartHour: 22, StopHour: 2, StopsNextDay: stopsNextDay(true)},
+			},
+			want: &model.MergeChanceSchedules{
+				Monday: []*model.MergeChanceSchedule{{StartHour: 9, StartMinute: 30, StopHour: 18}},
+				Friday: []*model.MergeChanceSchedule{{StartHour: 22, StopHour: 2, StopsNextDay: true}},
+			},
+		},
+		{
+			name: "whole day written back without stopsNextDay",
+			dto: &MergeChanceSchedulesToUpdate{
+				Sunday: &MergeChanceScheduleToUpdate{StartHour: 0, StopHour: 0},
+			},
+			want: &model.MergeChanceSchedules{
+				Sunday: []*model.MergeChanceSchedule{model.WholeDay},
+			},
+		},
+		{
+			name: "same start and stop on the same day",
+			dto: &MergeChanceSchedulesToUpdate{
+				Sunday: &MergeChanceScheduleToUpdate{StartHour: 10, StopHour: 10, StopsNextDay: stopsNextDay(false)},
+			},
+			want: &model.MergeChanceSchedules{
+				Sunday: []*model.MergeChanceSchedule{{StartHour: 10, StopHour: 10}},
+			},
+		},
+		{
+			name: "weekday fields keeping the current fields",
+			dto: &MergeChanceSchedulesToUpdate{
+				Monday: &MergeChanceScheduleToUpdate{StartHour: 10, StopHour: 18},
+				Friday: &MergeChanceScheduleToUpdate{StartHour: 23, StopHour: 3, StopsNextDay: stopsNextDay(true)},
+				Sunday: &MergeChanceScheduleToUpdate{StartHour: 0, StopHour: 0},
+			},
+			current: &model.MergeChanceSchedules{
+				Monday:   []*model.MergeChanceSchedule{{StartHour: 9, StopHour: 18}},
+				Tuesday:  []*model.MergeChanceSchedule{{StartHour: 9, StopHour: 12}, {StartHour: 13, StopHour: 18}},
+				Friday:   []*model.MergeChanceSchedule{{StartHour: 22, StopHour: 2, StopsNextDay: true}},
+				Saturday: []*model.MergeChanceSchedule{{StartHour: 9, StartMinute: 30, StopHour: 18}},
+				Sunday:   []*model.MergeChanceSchedule{model.WholeDay},
+			},
+			want: &model.MergeChanceSchedules{
+				Monday: []*model.MergeChanceSchedule{{StartHour: 10, StopHour: 18}},
+				Friday: []*model.MergeChanceSchedule{{StartHour: 23, StopHour: 3, StopsNextDay: true}},
+				Sunday: []*model.MergeChanceSchedule{model.WholeDay},
+			},
+		},
+		{
+			name: "weekday fields losing the current fields",
+			dto: &MergeChanceSchedulesToUpdate{
+				Tuesday:  &MergeChanceScheduleToUpdate{StartHour: 9, StopHour: 12},
+				Friday:   &MergeChanceScheduleToUpdate{StartHour: 22, StopHour: 2},
+				Saturday: &MergeChanceScheduleToUpdate{StartHour: 9, StopHour: 18},
+			},
+			current: &model.MergeChanceSchedules{
+				Tuesday:  []*model.MergeChanceSchedule{{StartHour: 9, StopHour: 12}, {StartHour: 13, StopHour: 18}},
+				Friday:   []*model.MergeChanceSchedule{{StartHour: 22, StopHour: 2, StopsNextDay: true}},
+				Saturday: []*model.MergeChanceSchedule{{StartHour: 9, StartMinute: 30, StopHour: 18}},
+			},
+			wantErr: []*model.FieldError{
+				{Field: "schedules.tuesday", Message: "cannot replace 2 windows; update them with windows"},
+				{Field: "schedules.friday.stopsNextDay", Message: "must be given to keep the current value true"},
+				{Field: "schedules.saturday.startMinute", Message: "must be given to keep the current value 30"},
+			},
+		},
+		{
+			name: "windows",
+			dto: &MergeChanceSchedulesToUpdate{
+				Monday: &MergeChanceScheduleToUpdate{StartHour: 9, StopHour: 18},
+				Windows: []*MergeChanceWindowToUpdate{
+					{Weekday: WeekdayTuesday, StartHour: 9, StopHour: 12},
+					{Weekday: WeekdayTuesday, StartHour: 13, StopHour: 18},
+				},
+			},
+			want: &model.MergeChanceSchedules{
+				Tuesday: []*model.MergeChanceSchedule{{StartHour: 9, StopHour: 12}, {StartHour: 13, StopHour: 18}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.dto.ToModel("schedules", tt.current)
+			if tt.wantErr != nil {
+				var verr *model.ValidationError
+				if !errors.As(err, &verr) || !reflect.DeepEqual(verr.Errors, tt.wantErr) {
+					t.Fatalf("error = %v, want %v", err, &model.ValidationError{Errors: tt.wantErr})
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ToModel() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/aereal/merge-chance-time/domain/model"
+	"github.com/aereal/merge-chance-time/domain/repo"
+)
+
+// ErrorCode is the code of the error exposed in the extensions of GraphQL errors.
+type ErrorCode string
+
+const (
+	// ErrorCodeNotFound is the code of errors the repository is not found or not visible to the user.
+	ErrorCodeNotFound ErrorCode = "NOT_FOUND"
+	// ErrorCodeForbidden is the code of errors the user does not have the permission required on the repository.
+	ErrorCodeForbidden ErrorCode = "FORBIDDEN"
+	// ErrorCodeNotInstalled is the code of errors the app is not installed on the repository.
+	ErrorCodeNotInstalled ErrorCode = "NOT_INSTALLED"
+	// ErrorCodeInvalidInput is the code of errors the input violates the constraints on the field in the extensions.
+	ErrorCodeInvalidInput ErrorCode = "INVALID_INPUT"
+	// ErrorCodeConflict is the code of errors the config has been updated by someone else since it was read, so the update must be retried.
+	ErrorCodeConflict ErrorCode = "CONFLICT"
+)
+
+// Error is an error with the code, which clients can tell apart without parsing messages.
+type Error struct {
+	Code    ErrorCode
+	Message string
+	// Field is the path to the invalid field of the input. It is set only for ErrorCodeInvalidInput.
+	Field string
+}
+
+var _ interface {
+	error
+	Extensions() map[string]interface{}
+} = &Error{}
+
+func newError(code ErrorCode, format string, args ...interface{}) *Error {
+	return &Error{Code: code, Message: fmt.Sprintf(format, args...)}
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+// Extensions implements graphql.ExtendedError.
+func (e *Error) Extensions() map[string]interface{} {
+	ext := map[string]interface{}{"code": string(e.Code)}
+	if e.Field != "" {
+		ext["field"] = e.Field
+	}
+	return ext
+}
+
+// validationError reports each violation in err as an error coded INVALID_INPUT if err is *model.ValidationError,
+// and returns the last one for the resolver to return. Other errors are returned as they are.
+func validationError(ctx context.Context, err error) error {
+	var verr *model.ValidationError
+	if !errors.As(err, &verr) {
+		return err
+	}
+	errs := make([]*Error, len(verr.Errors))
+	for i, fe := range verr.Errors {
+		errs[i] = &Error{Code: ErrorCodeInvalidInput, Message: fe.Error(), Field: fe.Field}
+	}
+	for _, e := range errs[:len(errs)-1] {
+		graphql.AddError(ctx, e)
+	}
+	return errs[len(errs)-1]
+}
+
+// conflictError returns the error coded CONFLICT if err is repo.ErrConflict, or err otherwise.
+// target is the owner or the repository whose config was updated.
+func conflictError(err error, target string) error {
+	if errors.Is(err, repo.ErrConflict) {
+		return newError(ErrorCodeConflict, "%s has been updated since it was read; reload it and try again", target)
+	}
+	return err
+}
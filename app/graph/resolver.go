@@ -3,14 +3,32 @@
 package graph
 
 import (
+	"context"
 	"fmt"
+	"net/http"
+	"strings"
+	"time"
 
+	"github.com/aereal/merge-chance-time/app/adapter/githubapi"
 	"github.com/aereal/merge-chance-time/app/adapter/githubapps"
 	"github.com/aereal/merge-chance-time/app/authz"
+	"github.com/aereal/merge-chance-time/app/graph/dto"
+	"github.com/aereal/merge-chance-time/domain/model"
 	"github.com/aereal/merge-chance-time/domain/repo"
+	"github.com/aereal/merge-chance-time/ical"
+	"github.com/aereal/merge-chance-time/usecase"
+	"github.com/google/go-github/v30/github"
 )
 
-func New(authorizer authz.Authorizer, ghAdapter githubapps.GitHubAppsAdapter, repo repo.Repository) (*Resolver, error) {
+// freezePeriodsImportRange is how far recurring events are expanded into freeze periods on import.
+const freezePeriodsImportRange = 365 * 24 * time.Hour
+
+const (
+	defaultAuditLogPageSize = 20
+	maxAuditLogPageSize     = 100
+)
+
+func New(authorizer authz.Authorizer, ghAdapter githubapps.GitHubAppsAdapter, repo repo.Repository, uc usecase.Usecase, minConfigPermission model.Permission) (*Resolver, error) {
 	if authorizer == nil {
 		return nil, fmt.Errorf("authorizer is nil")
 	}
@@ -20,10 +38,18 @@ func New(authorizer authz.Authorizer, ghAdapter githubapps.GitHubAppsAdapter, re
 	if repo == nil {
 		return nil, fmt.Errorf("repo is nil")
 	}
+	if uc == nil {
+		return nil, fmt.Errorf("usecase is nil")
+	}
+	if minConfigPermission == model.PermissionNone {
+		return nil, fmt.Errorf("minConfigPermission must not be none")
+	}
 	return &Resolver{
-		authorizer: authorizer,
-		ghAdapter:  ghAdapter,
-		repo:       repo,
+		authorizer:          authorizer,
+		ghAdapter:           ghAdapter,
+		repo:                repo,
+		usecase:             uc,
+		minConfigPermission: minConfigPermission,
 	}, nil
 }
 
@@ -31,4 +57,198 @@ type Resolver struct {
 	authorizer authz.Authorizer
 	ghAdapter  githubapps.GitHubAppsAdapter
 	repo       repo.Repository
+	usecase    usecase.Usecase
+	// minConfigPermission is the permission on a repository required to update its config.
+	minConfigPermission model.Permission
+}
+
+// authorizeConfigUpdate verifies that the user of the client has minConfigPermission on the repository and the app is installed on it.
+func (r *Resolver) authorizeConfigUpdate(ctx context.Context, client githubapi.Client, owner, name string) error {
+	ghRepo, err := visibleRepository(ctx, client, owner, name)
+	if err != nil {
+		return err
+	}
+	if perm := model.PermissionOf(ghRepo.GetPermissions()); perm < r.minConfigPermission {
+		return newError(ErrorCodeForbidden, "%s permission on %s/%s is required, but you have %s", r.minConfigPermission, owner, name, perm)
+	}
+
+	_, resp, err := r.ghAdapter.NewAppClient().Apps().FindRepositoryInstallation(ctx, owner, name)
+	if notFound(resp) {
+		return newError(ErrorCodeNotInstalled, "the app is not installed on %s/%s", owner, name)
+	}
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// authorizeCalendarUpdate verifies that the user of the client can update the calendar of the repository, or of the owner if name is nil.
+func (r *Resolver) authorizeCalendarUpdate(ctx context.Context, client githubapi.Client, owner string, name *string) error {
+	if name != nil {
+		return r.authorizeConfigUpdate(ctx, client, owner, *name)
+	}
+	return authorizeOwnerUpdate(ctx, client, owner)
+}
+
+// authorizeOwnerUpdate verifies that the user of the client can see the installation of the app on the owner,
+// and is the owner or an active admin of the organization.
+// Reading the membership requires the app to have the read permission of organization members.
+func authorizeOwnerUpdate(ctx context.Context, client githubapi.Client, owner string) error {
+	if err := authorizeOwnerAccess(ctx, client, owner); err != nil {
+		return err
+	}
+	user, _, err := client.Users().Get(ctx, "")
+	if err != nil {
+		return err
+	}
+	if strings.EqualFold(user.GetLogin(), owner) {
+		return nil
+	}
+	membership, resp, err := client.Organizations().GetOrgMembership(ctx, "", owner)
+	if notFound(resp) {
+		return newError(ErrorCodeForbidden, "admin membership of %s is required, but you are not a member", owner)
+	}
+	if err != nil {
+		return err
+	}
+	if membership.GetState() != "active" || membership.GetRole() != "admin" {
+		return newError(ErrorCodeForbidden, "admin membership of %s is required, but you are %s %s", owner, membership.GetState(), membership.GetRole())
+	}
+	return nil
+}
+
+// authorizeOwnerAccess verifies that the user of the client can see the installation of the app on the owner.
+func authorizeOwnerAccess(ctx context.Context, client githubapi.Client, owner string) error {
+	installations, err := githubapi.ListAllUserInstallations(ctx, client.Apps())
+	if err != nil {
+		return err
+	}
+	for _, installation := range installations {
+		if strings.EqualFold(installation.GetAccount().GetLogin(), owner) {
+			return nil
+		}
+	}
+	return newError(ErrorCodeNotFound, "installation of the app on %s is not found", owner)
+}
+
+// visibleRepository returns the repository if the user of the client can see it, or the error coded NOT_FOUND otherwise.
+func visibleRepository(ctx context.Context, client githubapi.Client, owner, name string) (*github.Repository, error) {
+	ghRepo, resp, err := client.Repositories().Get(ctx, owner, name)
+	if notFound(resp) {
+		return nil, newError(ErrorCodeNotFound, "repository %s/%s is not found", owner, name)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return ghRepo, nil
+}
+
+func notFound(resp *github.Response) bool {
+	return resp != nil && resp.Response != nil && resp.StatusCode == http.StatusNotFound
+}
+
+// updateCalendar applies update to the calendar of the repository, or of the owner if name is nil, and stores it if update reports a change.
+// It returns the error coded CONFLICT if the calendar has been updated since it was read.
+func (r *Resolver) updateCalendar(ctx context.Context, owner string, name *string, update func(calendar *model.Calendar) bool) (bool, error) {
+	if name == nil {
+		calendar, err := r.repo.GetOwnerCalendar(ctx, owner)
+		if err != nil {
+			return false, err
+		}
+		if !update(calendar) {
+			return false, nil
+		}
+		if err := r.repo.UpdateOwnerCalendar(ctx, owner, calendar); err != nil {
+			return false, conflictError(err, owner)
+		}
+		return true, nil
+	}
+
+	cfg, err := r.repo.GetRepositoryConfig(ctx, owner, *name)
+	if err != nil {
+		return false, err
+	}
+	if cfg.Calendar == nil {
+		cfg.Calendar = &model.Calendar{}
+	}
+	if !update(cfg.Calendar) {
+		return false, nil
+	}
+	if err := r.repo.UpdateRepositoryConfig(ctx, cfg); err != nil {
+		return false, conflictError(err, owner+"/"+*name)
+	}
+	return true, nil
+}
+
+// importLocation returns the location times without time zone in imported calendars are interpreted in.
+// It is timeZone if given, the time zone of the repository if name is given, or UTC otherwise.
+func (r *Resolver) importLocation(ctx context.Context, owner string, name *string, timeZone *string) (*time.Location, error) {
+	if timeZone != nil {
+		loc, err := time.LoadLocation(*timeZone)
+		if err != nil {
+			return nil, fmt.Errorf("invalid time zone %q: %w", *timeZone, err)
+		}
+		return loc, nil
+	}
+	if name == nil {
+		return time.UTC, nil
+	}
+	cfg, err := r.repo.GetRepositoryConfig(ctx, owner, *name)
+	if err != nil {
+		return nil, err
+	}
+	loc, err := cfg.Location()
+	if err != nil {
+		return nil, err
+	}
+	if loc == nil {
+		return time.Local, nil
+	}
+	return loc, nil
+}
+
+// freezePeriodsFromICS converts the events of the iCalendar document occurring from now into freeze periods.
+func freezePeriodsFromICS(ics string, loc *time.Location, now time.Time) ([]*model.FreezePeriod, error) {
+	calendar, err := ical.Parse(strings.NewReader(ics), loc)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse iCalendar: %w", err)
+	}
+	occurrences, err := calendar.Occurrences(now, now.Add(freezePeriodsImportRange))
+	if err != nil {
+		return nil, err
+	}
+	periods := []*model.FreezePeriod{}
+	for _, o := range occurrences {
+		if !o.End.After(o.Start) {
+			continue
+		}
+		periods = append(periods, &model.FreezePeriod{Start: o.Start, End: o.End, Summary: o.Event.Summary})
+	}
+	return periods, nil
+}
+
+// updatedBranchRules returns the branch rules to replace the rules of current.
+// Each rule keeps the state of the current rule with the same pattern, or inherits the state of the repository which governed the branches so far,
+// so that UpdateChanceTime updates the commit statuses if the state drifts from the new schedules.
+// It returns *model.ValidationError if a rule would lose the schedules of the current rule.
+func updatedBranchRules(current *model.RepositoryConfig, rules []*dto.BranchRuleToUpdate) ([]*model.BranchRule, error) {
+	currentRules := map[string]*model.BranchRule{}
+	for _, rule := range current.BranchRules {
+		currentRules[rule.Pattern] = rule
+	}
+	newRules := make([]*model.BranchRule, len(rules))
+	for i, r := range rules {
+		currentRule := currentRules[r.Pattern]
+		rule, err := r.ToModel(fmt.Sprintf("branchRules[%d]", i), currentRule)
+		if err != nil {
+			return nil, err
+		}
+		if currentRule != nil {
+			rule.MergeAvailable = currentRule.MergeAvailable
+		} else {
+			rule.MergeAvailable = current.MergeAvailable
+		}
+		newRules[i] = rule
+	}
+	return newRules, nil
 }
@@ -0,0 +1,571 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/aereal/merge-chance-time/app/adapter/githubapi"
+	"github.com/aereal/merge-chance-time/app/adapter/githubapps"
+	"github.com/aereal/merge-chance-time/app/authz"
+	"github.com/aereal/merge-chance-time/app/graph/dto"
+	"github.com/aereal/merge-chance-time/domain/model"
+	"github.com/aereal/merge-chance-time/domain/repo"
+	"github.com/aereal/merge-chance-time/usecase"
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-github/v30/github"
+)
+
+var (
+	notFoundResponse    = &github.Response{Response: &http.Response{StatusCode: http.StatusNotFound}}
+	serverErrorResponse = &github.Response{Response: &http.Response{StatusCode: http.StatusInternalServerError}}
+	errGitHub           = errors.New("GitHub failed")
+)
+
+// permissionsOf returns the permissions of the repository granted to the user having perm.
+func permissionsOf(perm model.Permission) map[string]bool {
+	return map[string]bool{
+		"pull":     perm >= model.PermissionRead,
+		"triage":   perm >= model.PermissionTriage,
+		"push":     perm >= model.PermissionWrite,
+		"maintain": perm >= model.PermissionMaintain,
+		"admin":    perm >= model.PermissionAdmin,
+	}
+}
+
+// assertError reports an error unless err is the *Error with wantCode, or is wantErr if wantCode is empty.
+func assertError(t *testing.T, err error, wantCode ErrorCode, wantErr error) {
+	t.Helper()
+	if wantCode == "" {
+		if !errors.Is(err, wantErr) {
+			t.Errorf("error = %v, want %v", err, wantErr)
+		}
+		return
+	}
+	var gerr *Error
+	if !errors.As(err, &gerr) {
+		t.Fatalf("error = %v, want the error coded %s", err, wantCode)
+	}
+	if gerr.Code != wantCode {
+		t.Errorf("error code = %s, want %s (%s)", gerr.Code, wantCode, gerr.Message)
+	}
+}
+
+func TestResolver_authorizeConfigUpdate(t *testing.T) {
+	tests := []struct {
+		name                string
+		minConfigPermission model.Permission
+		permissions         map[string]bool
+		repoResp            *github.Response
+		repoErr             error
+		checksInstallation  bool
+		installResp         *github.Response
+		installErr          error
+		wantCode            ErrorCode
+		wantErr             error
+	}{
+		{
+			name:                "admin",
+			minConfigPermission: model.PermissionAdmin,
+			permissions:         permissionsOf(model.PermissionAdmin),
+			checksInstallation:  true,
+		},
+		{
+			name:                "maintain required and granted",
+			minConfigPermission: model.PermissionMaintain,
+			permissions:         permissionsOf(model.PermissionMaintain),
+			checksInstallation:  true,
+		},
+		{
+			name:                "maintain",
+			minConfigPermission: model.PermissionAdmin,
+			permissions:         permissionsOf(model.PermissionMaintain),
+			wantCode:            ErrorCodeForbidden,
+		},
+		{
+			name:                "write",
+			minConfigPermission: model.PermissionAdmin,
+			permissions:         permissionsOf(model.PermissionWrite),
+			wantCode:            ErrorCodeForbidden,
+		},
+		{
+			name:                "triage",
+			minConfigPermission: model.PermissionWrite,
+			permissions:         permissionsOf(model.PermissionTriage),
+			wantCode:            ErrorCodeForbidden,
+		},
+		{
+			name:                "read",
+			minConfigPermission: model.PermissionTriage,
+			permissions:         permissionsOf(model.PermissionRead),
+			wantCode:            ErrorCodeForbidden,
+		},
+		{
+			name:                "no permissions",
+			minConfigPermission: model.PermissionRead,
+			permissions:         nil,
+			wantCode:            ErrorCodeForbidden,
+		},
+		{
+			name:                "repository not visible",
+			minConfigPermission: model.PermissionAdmin,
+			repoResp:            notFoundResponse,
+			repoErr:             errGitHub,
+			wantCode:            ErrorCodeNotFound,
+		},
+		{
+			name:                "repository lookup failed",
+			minConfigPermission: model.PermissionAdmin,
+			repoResp:            serverErrorResponse,
+			repoErr:             errGitHub,
+			wantErr:             errGitHub,
+		},
+		{
+			name:                "not installed",
+			minConfigPermission: model.PermissionAdmin,
+			permissions:         permissionsOf(model.PermissionAdmin),
+			checksInstallation:  true,
+			installResp:         notFoundResponse,
+			installErr:          errGitHub,
+			wantCode:            ErrorCodeNotInstalled,
+		},
+		{
+			name:                "installation lookup failed",
+			minConfigPermission: model.PermissionAdmin,
+			permissions:         permissionsOf(model.PermissionAdmin),
+			checksInstallation:  true,
+			installResp:         serverErrorResponse,
+			installErr:          errGitHub,
+			wantErr:             errGitHub,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ghRepo := &github.Repository{Name: github.String("example-repo")}
+			if tt.permissions != nil {
+				ghRepo.Permissions = &tt.permissions
+			}
+			repoSrv := githubapi.NewMockRepositoriesService(ctrl)
+			repoSrv.EXPECT().Get(gomock.Any(), "aereal", "example-repo").Times(1).Return(ghRepo, tt.repoResp, tt.repoErr)
+			client := githubapi.NewMockClient(ctrl)
+			client.EXPECT().Repositories().Return(repoSrv)
+
+			adapter := githubapps.NewMockGitHubAppsAdapter(ctrl)
+			if tt.checksInstallation {
+				apps := githubapi.NewMockAppsService(ctrl)
+				apps.EXPECT().FindRepositoryInstallation(gomock.Any(), "aereal", "example-repo").Times(1).
+					Return(&github.Installation{ID: github.Int64(1234)}, tt.installResp, tt.installErr)
+				appClient := githubapi.NewMockClient(ctrl)
+				appClient.EXPECT().Apps().Return(apps)
+				adapter.EXPECT().NewAppClient().Return(appClient)
+			}
+
+			r := &Resolver{ghAdapter: adapter, minConfigPermission: tt.minConfigPermission}
+			err := r.authorizeConfigUpdate(context.Background(), client, "aereal", "example-repo")
+			assertError(t, err, tt.wantCode, tt.wantErr)
+		})
+	}
+}
+
+func Test_visibleRepository(t *testing.T) {
+	tests := []struct {
+		name     string
+		resp     *github.Response
+		err      error
+		wantCode ErrorCode
+		wantErr  error
+	}{
+		{
+			name: "visible",
+		},
+		{
+			name:     "not found",
+			resp:     notFoundResponse,
+			err:      errGitHub,
+			wantCode: ErrorCodeNotFound,
+		},
+		{
+			name:    "failed",
+			resp:    serverErrorResponse,
+			err:     errGitHub,
+			wantErr: errGitHub,
+		},
+		{
+			name:    "failed without response",
+			err:     errGitHub,
+			wantErr: errGitHub,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ghRepo := &github.Repository{Name: github.String("example-repo")}
+			repoSrv := githubapi.NewMockRepositoriesService(ctrl)
+			repoSrv.EXPECT().Get(gomock.Any(), "aereal", "example-repo").Times(1).Return(ghRepo, tt.resp, tt.err)
+			client := githubapi.NewMockClient(ctrl)
+			client.EXPECT().Repositories().Return(repoSrv)
+
+			got, err := visibleRepository(context.Background(), client, "aereal", "example-repo")
+			assertError(t, err, tt.wantCode, tt.wantErr)
+			if err == nil && got != ghRepo {
+				t.Errorf("visibleRepository() = %v, want %v", got, ghRepo)
+			}
+		})
+	}
+}
+
+// userFixture is the mocks of the user signed in to the API with the permission on aereal/example-repo.
+// aereal/private-repo is not visible to the user.
+type userFixture struct {
+	authorizer *authz.MockAuthorizer
+	adapter    *githubapps.MockGitHubAppsAdapter
+	client     *githubapi.MockClient
+}
+
+func newUserFixture(ctrl *gomock.Controller, perm model.Permission) *userFixture {
+	f := &userFixture{
+		authorizer: authz.NewMockAuthorizer(ctrl),
+		adapter:    githubapps.NewMockGitHubAppsAdapter(ctrl),
+		client:     githubapi.NewMockClient(ctrl),
+	}
+	f.authorizer.EXPECT().GetCurrentClaims(gomock.Any()).AnyTimes().Return(&authz.AppClaims{AccessToken: "0xdeadbeaf"}, nil)
+	f.adapter.EXPECT().NewUserClient(gomock.Any(), "0xdeadbeaf").AnyTimes().Return(f.client)
+
+	permissions := permissionsOf(perm)
+	repoSrv := githubapi.NewMockRepositoriesService(ctrl)
+	repoSrv.EXPECT().Get(gomock.Any(), "aereal", "example-repo").AnyTimes().
+		Return(&github.Repository{Name: github.String("example-repo"), Permissions: &permissions}, nil, nil)
+	repoSrv.EXPECT().Get(gomock.Any(), "aereal", "private-repo").AnyTimes().Return(nil, notFoundResponse, errGitHub)
+	f.client.EXPECT().Repositories().AnyTimes().Return(repoSrv)
+
+	apps := githubapi.NewMockAppsService(ctrl)
+	apps.EXPECT().FindRepositoryInstallation(gomock.Any(), "aereal", "example-repo").AnyTimes().
+		Return(&github.Installation{ID: github.Int64(1234)}, nil, nil)
+	appClient := githubapi.NewMockClient(ctrl)
+	appClient.EXPECT().Apps().AnyTimes().Return(apps)
+	f.adapter.EXPECT().NewAppClient().AnyTimes().Return(appClient)
+
+	users := githubapi.NewMockUsersService(ctrl)
+	users.EXPECT().Get(gomock.Any(), "").AnyTimes().Return(&github.User{Login: github.String("aereal")}, nil, nil)
+	f.client.EXPECT().Users().AnyTimes().Return(users)
+	return f
+}
+
+func (f *userFixture) resolver(r repo.Repository, uc usecase.Usecase) *Resolver {
+	return &Resolver{authorizer: f.authorizer, ghAdapter: f.adapter, repo: r, usecase: uc, minConfigPermission: model.PermissionAdmin}
+}
+
+func TestMutationResolver_ForceMergeWindow(t *testing.T) {
+	until := time.Date(2020, time.February, 3, 18, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name     string
+		perm     model.Permission
+		ucErr    error
+		wantCode ErrorCode
+	}{
+		{name: "admin", perm: model.PermissionAdmin},
+		{name: "updated since read", perm: model.PermissionAdmin, ucErr: fmt.Errorf("failed to update config: %w", repo.ErrConflict), wantCode: ErrorCodeConflict},
+		{name: "write", perm: model.PermissionWrite, wantCode: ErrorCodeForbidden},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			f := newUserFixture(ctrl, tt.perm)
+			uc := usecase.NewMockUsecase(ctrl)
+			if tt.perm >= model.PermissionAdmin {
+				uc.EXPECT().ForceMergeWindow(gomock.Any(), f.adapter, "aereal", "example-repo", gomock.Any()).Times(1).Return(tt.ucErr)
+			}
+			res := &mutationResolver{f.resolver(repo.NewMockRepository(ctrl), uc)}
+			got, err := res.ForceMergeWindow(context.Background(), "aereal", "example-repo", dto.MergeStateOpen, until)
+			if tt.wantCode == "" {
+				if err != nil || !got {
+					t.Errorf("ForceMergeWindow() = (%v, %v), want (true, nil)", got, err)
+				}
+				return
+			}
+			assertError(t, err, tt.wantCode, nil)
+		})
+	}
+}
+
+func TestMutationResolver_IssueCalendarFeed(t *testing.T) {
+	tests := []struct {
+		name     string
+		repoName string
+		wantCode ErrorCode
+	}{
+		{name: "visible", repoName: "example-repo"},
+		{name: "not visible", repoName: "private-repo", wantCode: ErrorCodeNotFound},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			f := newUserFixture(ctrl, model.PermissionRead)
+			r := repo.NewMockRepository(ctrl)
+			if tt.wantCode == "" {
+				r.EXPECT().GetRepositoryConfig(gomock.Any(), "aereal", tt.repoName).Times(1).
+					Return(&model.RepositoryConfig{Owner: "aereal", Name: tt.repoName, FeedGeneration: 2}, nil)
+				f.authorizer.EXPECT().IssueFeedToken(&authz.FeedClaims{Owner: "aereal", Name: tt.repoName, Generation: 2}).Times(1).Return("0xfeed", nil)
+			}
+			res := &mutationResolver{f.resolver(r, usecase.NewMockUsecase(ctrl))}
+			got, err := res.IssueCalendarFeed(context.Background(), "aereal", tt.repoName)
+			if tt.wantCode == "" {
+				if err != nil || got.Token != "0xfeed" {
+					t.Errorf("IssueCalendarFeed() = (%v, %v), want token 0xfeed", got, err)
+				}
+				return
+			}
+			assertError(t, err, tt.wantCode, nil)
+		})
+	}
+}
+
+func TestMutationResolver_RevokeCalendarFeeds(t *testing.T) {
+	readAt := time.Date(2020, time.February, 3, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name     string
+		perm     model.Permission
+		storeErr error
+		wantCode ErrorCode
+	}{
+		{name: "admin", perm: model.PermissionAdmin},
+		{name: "updated since read", perm: model.PermissionAdmin, storeErr: repo.ErrConflict, wantCode: ErrorCodeConflict},
+		{name: "read", perm: model.PermissionRead, wantCode: ErrorCodeForbidden},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			f := newUserFixture(ctrl, tt.perm)
+			r := repo.NewMockRepository(ctrl)
+			if tt.perm >= model.PermissionAdmin {
+				r.EXPECT().GetRepositoryConfig(gomock.Any(), "aereal", "example-repo").Times(1).
+					Return(&model.RepositoryConfig{Owner: "aereal", Name: "example-repo", FeedGeneration: 2, UpdateTime: readAt}, nil)
+				r.EXPECT().UpdateRepositoryConfig(gomock.Any(), &model.RepositoryConfig{Owner: "aereal", Name: "example-repo", FeedGeneration: 3, UpdateTime: readAt}).Times(1).Return(tt.storeErr)
+			}
+			res := &mutationResolver{f.resolver(r, usecase.NewMockUsecase(ctrl))}
+			got, err := res.RevokeCalendarFeeds(context.Background(), "aereal", "example-repo")
+			if tt.wantCode == "" {
+				if err != nil || !got {
+					t.Errorf("RevokeCalendarFeeds() = (%v, %v), want (true, nil)", got, err)
+				}
+				return
+			}
+			assertError(t, err, tt.wantCode, nil)
+		})
+	}
+}
+
+// expectInstallations lets the user see the installations of the app on the accounts.
+func (f *userFixture) expectInstallations(ctrl *gomock.Controller, accounts ...string) {
+	installations := make([]*github.Installation, len(accounts))
+	for i, account := range accounts {
+		installations[i] = &github.Installation{ID: github.Int64(int64(i + 1)), Account: &github.User{Login: github.String(account)}}
+	}
+	apps := githubapi.NewMockAppsService(ctrl)
+	apps.EXPECT().ListUserInstallations(gomock.Any(), gomock.Any()).AnyTimes().Return(installations, &github.Response{}, nil)
+	f.client.EXPECT().Apps().AnyTimes().Return(apps)
+}
+
+func Test_authorizeOwnerUpdate(t *testing.T) {
+	tests := []struct {
+		name          string
+		owner         string
+		installations []string
+		membership    *github.Membership
+		memberResp    *github.Response
+		memberErr     error
+		wantCode      ErrorCode
+		wantErr       error
+	}{
+		{
+			name:          "user itself",
+			owner:         "aereal",
+			installations: []string{"aereal"},
+		},
+		{
+			name:          "organization admin",
+			owner:         "example-org",
+			installations: []string{"example-org"},
+			membership:    &github.Membership{State: github.String("active"), Role: github.String("admin")},
+		},
+		{
+			name:          "organization member",
+			owner:         "example-org",
+			installations: []string{"example-org"},
+			membership:    &github.Membership{State: github.String("active"), Role: github.String("member")},
+			wantCode:      ErrorCodeForbidden,
+		},
+		{
+			name:          "invited organization admin",
+			owner:         "example-org",
+			installations: []string{"example-org"},
+			membership:    &github.Membership{State: github.String("pending"), Role: github.String("admin")},
+			wantCode:      ErrorCodeForbidden,
+		},
+		{
+			name:          "not a member",
+			owner:         "example-org",
+			installations: []string{"example-org"},
+			memberResp:    notFoundResponse,
+			memberErr:     errGitHub,
+			wantCode:      ErrorCodeForbidden,
+		},
+		{
+			name:          "membership lookup failed",
+			owner:         "example-org",
+			installations: []string{"example-org"},
+			memberResp:    serverErrorResponse,
+			memberErr:     errGitHub,
+			wantErr:       errGitHub,
+		},
+		{
+			name:          "installation not visible",
+			owner:         "example-org",
+			installations: []string{"aereal"},
+			wantCode:      ErrorCodeNotFound,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			f := newUserFixture(ctrl, model.PermissionNone)
+			f.expectInstallations(ctrl, tt.installations...)
+			if tt.membership != nil || tt.memberErr != nil {
+				orgs := githubapi.NewMockOrganizationsService(ctrl)
+				orgs.EXPECT().GetOrgMembership(gomock.Any(), "", tt.owner).Times(1).Return(tt.membership, tt.memberResp, tt.memberErr)
+				f.client.EXPECT().Organizations().Return(orgs)
+			}
+
+			err := authorizeOwnerUpdate(context.Background(), f.client, tt.owner)
+			assertError(t, err, tt.wantCode, tt.wantErr)
+		})
+	}
+}
+
+func TestMutationResolver_AddExceptionDate(t *testing.T) {
+	exampleRepo := "example-repo"
+	tests := []struct {
+		name     string
+		owner    string
+		repoName *string
+		perm     model.Permission
+		wantCode ErrorCode
+	}{
+		{name: "repository admin", owner: "aereal", repoName: &exampleRepo, perm: model.PermissionAdmin},
+		{name: "repository writer", owner: "aereal", repoName: &exampleRepo, perm: model.PermissionWrite, wantCode: ErrorCodeForbidden},
+		{name: "owner", owner: "aereal"},
+		{name: "other owner", owner: "example-org", wantCode: ErrorCodeNotFound},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			f := newUserFixture(ctrl, tt.perm)
+			f.expectInstallations(ctrl, "aereal")
+			r := repo.NewMockRepository(ctrl)
+			if tt.wantCode == "" {
+				if tt.repoName != nil {
+					r.EXPECT().GetRepositoryConfig(gomock.Any(), tt.owner, *tt.repoName).Return(&model.RepositoryConfig{Owner: tt.owner, Name: *tt.repoName}, nil)
+					r.EXPECT().UpdateRepositoryConfig(gomock.Any(), gomock.Any()).Return(nil)
+				} else {
+					r.EXPECT().GetOwnerCalendar(gomock.Any(), tt.owner).Return(&model.Calendar{}, nil)
+					r.EXPECT().UpdateOwnerCalendar(gomock.Any(), tt.owner, gomock.Any()).Return(nil)
+				}
+			}
+			res := &mutationResolver{f.resolver(r, usecase.NewMockUsecase(ctrl))}
+			got, err := res.AddExceptionDate(context.Background(), tt.owner, tt.repoName, "2020-12-31", nil)
+			if tt.wantCode == "" {
+				if err != nil || !got {
+					t.Errorf("AddExceptionDate() = (%v, %v), want (true, nil)", got, err)
+				}
+				return
+			}
+			assertError(t, err, tt.wantCode, nil)
+		})
+	}
+}
+
+func TestQueryResolver_OwnerExceptionDates(t *testing.T) {
+	tests := []struct {
+		name     string
+		owner    string
+		wantCode ErrorCode
+	}{
+		{name: "visible", owner: "example-org"},
+		{name: "not visible", owner: "other-org", wantCode: ErrorCodeNotFound},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			f := newUserFixture(ctrl, model.PermissionNone)
+			f.expectInstallations(ctrl, "aereal", "example-org")
+			r := repo.NewMockRepository(ctrl)
+			if tt.wantCode == "" {
+				r.EXPECT().GetOwnerCalendar(gomock.Any(), tt.owner).Times(1).Return(&model.Calendar{}, nil)
+			}
+			res := &queryResolver{f.resolver(r, usecase.NewMockUsecase(ctrl))}
+			_, err := res.OwnerExceptionDates(context.Background(), tt.owner)
+			if tt.wantCode == "" {
+				if err != nil {
+					t.Errorf("OwnerExceptionDates() error = %v", err)
+				}
+				return
+			}
+			assertError(t, err, tt.wantCode, nil)
+		})
+	}
+}
+
+func TestMutationResolver_ImportFreezePeriods(t *testing.T) {
+	exampleRepo := "example-repo"
+	tests := []struct {
+		name     string
+		owner    string
+		repoName *string
+		perm     model.Permission
+		wantCode ErrorCode
+	}{
+		{name: "repository writer", owner: "aereal", repoName: &exampleRepo, perm: model.PermissionWrite, wantCode: ErrorCodeForbidden},
+		{name: "other owner", owner: "example-org", wantCode: ErrorCodeNotFound},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			f := newUserFixture(ctrl, tt.perm)
+			f.expectInstallations(ctrl, "aereal")
+			res := &mutationResolver{f.resolver(repo.NewMockRepository(ctrl), usecase.NewMockUsecase(ctrl))}
+			_, err := res.ImportFreezePeriods(context.Background(), tt.owner, tt.repoName, "BEGIN:VCALENDAR\r\nEND:VCALENDAR\r\n", nil)
+			assertError(t, err, tt.wantCode, nil)
+		})
+	}
+}
@@ -5,7 +5,11 @@ package graph
 
 import (
 	"context"
+	"fmt"
+	"time"
 
+	"github.com/aereal/merge-chance-time/app/adapter/githubapi"
+	"github.com/aereal/merge-chance-time/app/authz"
 	"github.com/aereal/merge-chance-time/app/graph/dto"
 	"github.com/aereal/merge-chance-time/app/graph/generated"
 	"github.com/aereal/merge-chance-time/domain/model"
@@ -19,7 +23,7 @@ func (r *installationResolver) InstalledRepositories(ctx context.Context, obj *d
 	}
 	client := r.ghAdapter.NewUserClient(ctx, claims.AccessToken)
 
-	rs, _, err := client.Apps().ListUserRepos(ctx, obj.ID, nil)
+	rs, err := githubapi.ListAllUserRepos(ctx, client.Apps(), obj.ID)
 	if err != nil {
 		return nil, err
 	}
@@ -31,32 +35,217 @@ func (r *installationResolver) InstalledRepositories(ctx context.Context, obj *d
 }
 
 func (r *mutationResolver) UpdateRepositoryConfig(ctx context.Context, owner string, name string, config dto.RepositoryConfigToUpdate) (bool, error) {
-	_, err := r.authorizer.GetCurrentClaims(ctx)
+	claims, err := r.authorizer.GetCurrentClaims(ctx)
+	if err != nil {
+		return false, err
+	}
+	client := r.ghAdapter.NewUserClient(ctx, claims.AccessToken)
+	if err := r.authorizeConfigUpdate(ctx, client, owner, name); err != nil {
+		return false, err
+	}
+	user, _, err := client.Users().Get(ctx, "")
 	if err != nil {
 		return false, err
 	}
 
 	current, err := r.repo.GetRepositoryConfig(ctx, owner, name)
-	if err == repo.ErrNotFound {
-		current = &model.RepositoryConfig{}
+	exists := err != repo.ErrNotFound
+	if !exists {
+		current, err = &model.RepositoryConfig{}, nil
 	}
 	if err != nil {
 		return false, err
 	}
 
-	schedules := config.Schedules.ToModel()
-	cfgs := []*model.RepositoryConfig{
-		{
-			Owner:          owner,
-			Name:           name,
-			MergeAvailable: current.MergeAvailable,
-			Schedules:      schedules,
-		},
+	newConfig := *current
+	newConfig.Owner = owner
+	newConfig.Name = name
+	newConfig.Schedules, err = config.Schedules.ToModel("schedules", current.Schedules)
+	if err != nil {
+		return false, validationError(ctx, err)
+	}
+	if config.TimeZone != nil {
+		newConfig.TimeZone = *config.TimeZone
+	}
+	if config.BypassLabel != nil {
+		newConfig.BypassLabel = *config.BypassLabel
+	}
+	if config.BaseBranchPatterns != nil {
+		newConfig.BaseBranchPatterns = config.BaseBranchPatterns
+	}
+	if config.BranchRules != nil {
+		newConfig.BranchRules, err = updatedBranchRules(current, config.BranchRules)
+		if err != nil {
+			return false, validationError(ctx, err)
+		}
+	}
+	if config.StatusContext != nil {
+		newConfig.StatusContext = *config.StatusContext
+	}
+	if config.OpenDescription != nil {
+		newConfig.OpenDescription = *config.OpenDescription
+	}
+	if config.ClosedDescription != nil {
+		newConfig.ClosedDescription = *config.ClosedDescription
 	}
-	if err := r.repo.PutRepositoryConfigs(ctx, cfgs); err != nil {
+	if err := newConfig.Valid(); err != nil {
+		return false, validationError(ctx, err)
+	}
+	if err := r.repo.UpdateRepositoryConfig(ctx, &newConfig); err != nil {
+		return false, conflictError(err, owner+"/"+name)
+	}
+	before := current
+	if !exists {
+		before = nil
+	}
+	now := time.Now()
+	if err := r.repo.AddAuditEvents(ctx, []*model.AuditEvent{newConfig.ConfigUpdatedEvent(before, user.GetLogin(), now)}); err != nil {
+		return false, fmt.Errorf("config updated but failed to record the audit log: %w", err)
+	}
+	if exists {
+		if err := r.usecase.MigrateStatusContext(ctx, r.ghAdapter, &newConfig, current.StatusContextName(), now); err != nil {
+			return false, fmt.Errorf("config updated but failed to migrate statuses: %w", err)
+		}
+	}
+
+	return true, nil
+}
+
+func (r *mutationResolver) AddExceptionDate(ctx context.Context, owner string, name *string, date string, reason *string) (bool, error) {
+	claims, err := r.authorizer.GetCurrentClaims(ctx)
+	if err != nil {
+		return false, err
+	}
+	client := r.ghAdapter.NewUserClient(ctx, claims.AccessToken)
+	if err := r.authorizeCalendarUpdate(ctx, client, owner, name); err != nil {
 		return false, err
 	}
 
+	d, err := model.ParseDate(date)
+	if err != nil {
+		return false, err
+	}
+	exceptionDate := &model.ExceptionDate{Date: d}
+	if reason != nil {
+		exceptionDate.Reason = *reason
+	}
+	return r.updateCalendar(ctx, owner, name, func(calendar *model.Calendar) bool {
+		calendar.AddExceptionDate(exceptionDate)
+		return true
+	})
+}
+
+func (r *mutationResolver) RemoveExceptionDate(ctx context.Context, owner string, name *string, date string) (bool, error) {
+	claims, err := r.authorizer.GetCurrentClaims(ctx)
+	if err != nil {
+		return false, err
+	}
+	client := r.ghAdapter.NewUserClient(ctx, claims.AccessToken)
+	if err := r.authorizeCalendarUpdate(ctx, client, owner, name); err != nil {
+		return false, err
+	}
+
+	d, err := model.ParseDate(date)
+	if err != nil {
+		return false, err
+	}
+	return r.updateCalendar(ctx, owner, name, func(calendar *model.Calendar) bool {
+		return calendar.RemoveExceptionDate(d)
+	})
+}
+
+func (r *mutationResolver) ImportFreezePeriods(ctx context.Context, owner string, name *string, ics string, timeZone *string) (int, error) {
+	claims, err := r.authorizer.GetCurrentClaims(ctx)
+	if err != nil {
+		return 0, err
+	}
+	client := r.ghAdapter.NewUserClient(ctx, claims.AccessToken)
+	if err := r.authorizeCalendarUpdate(ctx, client, owner, name); err != nil {
+		return 0, err
+	}
+
+	loc, err := r.importLocation(ctx, owner, name, timeZone)
+	if err != nil {
+		return 0, err
+	}
+	periods, err := freezePeriodsFromICS(ics, loc, time.Now())
+	if err != nil {
+		return 0, err
+	}
+	if _, err := r.updateCalendar(ctx, owner, name, func(calendar *model.Calendar) bool {
+		calendar.ReplaceFreezePeriods(periods)
+		return true
+	}); err != nil {
+		return 0, err
+	}
+	return len(periods), nil
+}
+
+func (r *mutationResolver) IssueCalendarFeed(ctx context.Context, owner string, name string) (*dto.CalendarFeed, error) {
+	claims, err := r.authorizer.GetCurrentClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+	client := r.ghAdapter.NewUserClient(ctx, claims.AccessToken)
+	if _, err := visibleRepository(ctx, client, owner, name); err != nil {
+		return nil, err
+	}
+
+	cfg, err := r.repo.GetRepositoryConfig(ctx, owner, name)
+	if err != nil {
+		return nil, err
+	}
+	token, err := r.authorizer.IssueFeedToken(&authz.FeedClaims{Owner: owner, Name: name, Generation: cfg.FeedGeneration})
+	if err != nil {
+		return nil, err
+	}
+	return dto.NewCalendarFeed(owner, name, token), nil
+}
+
+func (r *mutationResolver) RevokeCalendarFeeds(ctx context.Context, owner string, name string) (bool, error) {
+	claims, err := r.authorizer.GetCurrentClaims(ctx)
+	if err != nil {
+		return false, err
+	}
+	client := r.ghAdapter.NewUserClient(ctx, claims.AccessToken)
+	if err := r.authorizeConfigUpdate(ctx, client, owner, name); err != nil {
+		return false, err
+	}
+
+	cfg, err := r.repo.GetRepositoryConfig(ctx, owner, name)
+	if err != nil {
+		return false, err
+	}
+	cfg.FeedGeneration++
+	if err := r.repo.UpdateRepositoryConfig(ctx, cfg); err != nil {
+		return false, conflictError(err, owner+"/"+name)
+	}
+	return true, nil
+}
+
+func (r *mutationResolver) ForceMergeWindow(ctx context.Context, owner string, name string, state dto.MergeState, until time.Time) (bool, error) {
+	claims, err := r.authorizer.GetCurrentClaims(ctx)
+	if err != nil {
+		return false, err
+	}
+	client := r.ghAdapter.NewUserClient(ctx, claims.AccessToken)
+	if err := r.authorizeConfigUpdate(ctx, client, owner, name); err != nil {
+		return false, err
+	}
+	user, _, err := client.Users().Get(ctx, "")
+	if err != nil {
+		return false, err
+	}
+
+	override := &model.Override{
+		MergeAvailable: state.ToModel(),
+		Until:          until,
+		CreatedBy:      user.GetLogin(),
+		CreatedAt:      time.Now(),
+	}
+	if err := r.usecase.ForceMergeWindow(ctx, r.ghAdapter, owner, name, override); err != nil {
+		return false, conflictError(err, owner+"/"+name)
+	}
 	return true, nil
 }
 
@@ -81,6 +270,40 @@ func (r *queryResolver) Repository(ctx context.Context, owner string, name strin
 	return dto.NewRepositoryFromResponse(ghRepo), nil
 }
 
+func (r *queryResolver) OwnerExceptionDates(ctx context.Context, owner string) ([]*dto.ExceptionDate, error) {
+	claims, err := r.authorizer.GetCurrentClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+	client := r.ghAdapter.NewUserClient(ctx, claims.AccessToken)
+	if err := authorizeOwnerAccess(ctx, client, owner); err != nil {
+		return nil, err
+	}
+
+	calendar, err := r.repo.GetOwnerCalendar(ctx, owner)
+	if err != nil {
+		return nil, err
+	}
+	return dto.NewExceptionDates(calendar), nil
+}
+
+func (r *queryResolver) OwnerFreezePeriods(ctx context.Context, owner string) ([]*dto.FreezePeriod, error) {
+	claims, err := r.authorizer.GetCurrentClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+	client := r.ghAdapter.NewUserClient(ctx, claims.AccessToken)
+	if err := authorizeOwnerAccess(ctx, client, owner); err != nil {
+		return nil, err
+	}
+
+	calendar, err := r.repo.GetOwnerCalendar(ctx, owner)
+	if err != nil {
+		return nil, err
+	}
+	return dto.NewFreezePeriods(calendar), nil
+}
+
 func (r *repositoryResolver) Config(ctx context.Context, obj *dto.Repository) (*dto.RepositoryConfig, error) {
 	cfg, err := r.repo.GetRepositoryConfig(ctx, obj.Owner.GetLogin(), obj.Name)
 	if err == repo.ErrNotFound {
@@ -89,10 +312,26 @@ func (r *repositoryResolver) Config(ctx context.Context, obj *dto.Repository) (*
 	if err != nil {
 		return nil, err
 	}
-	return &dto.RepositoryConfig{
-		MergeAvailable: cfg.MergeAvailable,
-		Schedules:      dto.NewMergeChanceSchedules(cfg.Schedules),
-	}, nil
+	return dto.NewRepositoryConfig(cfg), nil
+}
+
+func (r *repositoryResolver) AuditLog(ctx context.Context, obj *dto.Repository, first *int, after *string) (*dto.AuditEventConnection, error) {
+	limit := defaultAuditLogPageSize
+	if first != nil {
+		limit = *first
+	}
+	if limit < 1 || limit > maxAuditLogPageSize {
+		return nil, fmt.Errorf("first must be between 1 and %d", maxAuditLogPageSize)
+	}
+	cursor := ""
+	if after != nil {
+		cursor = *after
+	}
+	events, hasNextPage, err := r.repo.ListAuditEvents(ctx, obj.Owner.GetLogin(), obj.Name, limit, cursor)
+	if err != nil {
+		return nil, err
+	}
+	return dto.NewAuditEventConnection(events, hasNextPage), nil
 }
 
 func (r *visitorResolver) Login(ctx context.Context, obj *dto.Visitor) (string, error) {
@@ -114,7 +353,7 @@ func (r *visitorResolver) Installations(ctx context.Context, obj *dto.Visitor) (
 		return nil, err
 	}
 	client := r.ghAdapter.NewUserClient(ctx, claims.AccessToken)
-	installations, _, err := client.Apps().ListUserInstallations(ctx, nil)
+	installations, err := githubapi.ListAllUserInstallations(ctx, client.Apps())
 	if err != nil {
 		return nil, err
 	}
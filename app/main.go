@@ -22,6 +22,7 @@ import (
 	"github.com/aereal/merge-chance-time/app/web"
 	"github.com/aereal/merge-chance-time/authflow"
 	"github.com/aereal/merge-chance-time/domain/repo"
+	"github.com/aereal/merge-chance-time/domain/service"
 	"github.com/aereal/merge-chance-time/jwtissuer"
 	"github.com/aereal/merge-chance-time/usecase"
 	"github.com/dgrijalva/jwt-go"
@@ -66,7 +67,7 @@ func run() error {
 		defer exporter.Flush()
 		trace.RegisterExporter(exporter)
 
-		if err := view.Register(ochttp.ClientSentBytesDistribution, ochttp.ClientReceivedBytesDistribution, ochttp.ClientLatencyView, ochttp.ClientCompletedCount, ochttp.ClientRoundtripLatencyDistribution); err != nil {
+		if err := view.Register(ochttp.ClientSentBytesDistribution, ochttp.ClientReceivedBytesDistribution, ochttp.ClientLatencyView, ochttp.ClientCompletedCount, ochttp.ClientRoundtripLatencyDistribution, githubapps.TokenCacheRequestCountView); err != nil {
 			return err
 		}
 		httpClient.Transport = &ochttp.Transport{}
@@ -109,16 +110,25 @@ func run() error {
 		return err
 	}
 
-	uc, err := usecase.New(r)
+	reporter := service.ReportCommitStatus
+	if cfg.GitHubAppConfig.UseCheckRun {
+		reporter = service.ReportCheckRun
+	}
+	srv, err := service.New(reporter, cfg.AdminOrigin)
+	if err != nil {
+		return err
+	}
+
+	uc, err := usecase.New(r, srv)
 	if err != nil {
 		return err
 	}
 
-	resolver, err := graph.New(authorizer, ghAdapter, r)
+	resolver, err := graph.New(authorizer, ghAdapter, r, uc, cfg.GitHubAppConfig.MinConfigPermission)
 	if err != nil {
 		return err
 	}
-	es := generated.NewExecutableSchema(generated.Config{Resolvers: resolver})
+	es := generated.NewExecutableSchema(generated.Config{Resolvers: resolver, Directives: resolver.Directives()})
 
 	w := web.New(onGAE, cfg, ghAdapter, uc, ghAuthFlow, authorizer, es)
 	server := w.Server(cfg.ListenPort)
@@ -3,6 +3,7 @@ package web
 import (
 	"bytes"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"reflect"
@@ -14,9 +15,13 @@ import (
 	"github.com/aereal/merge-chance-time/app/authz"
 	"github.com/aereal/merge-chance-time/app/graph"
 	"github.com/aereal/merge-chance-time/app/graph/generated"
+	"github.com/aereal/merge-chance-time/domain/model"
 	"github.com/aereal/merge-chance-time/domain/repo"
+	"github.com/aereal/merge-chance-time/usecase"
 	"github.com/golang/mock/gomock"
 	"github.com/google/go-github/v30/github"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
 )
 
 func TestQuery(t *testing.T) {
@@ -70,10 +75,74 @@ func TestQuery(t *testing.T) {
 					authorizer: a,
 					adapter:    ad,
 					repo:       r,
+					usecase:    usecase.NewMockUsecase(ctrl),
 				}
 				return aggr
 			},
 		},
+		{
+			name:       "config",
+			statusCode: http.StatusOK,
+			params: graphql.RawParams{
+				Query: "query($owner: String!, $name: String!) {repository(owner: $owner, name: $name){id config{statusContext}}}",
+				Variables: map[string]interface{}{
+					"owner": "aereal",
+					"name":  "example-repo",
+				},
+			},
+			expected: graphql.Response{
+				Data: json.RawMessage(`{"repository":{"id":1234,"config":{"statusContext":"merge-chance-time"}}}`),
+			},
+			build: func(ctrl *gomock.Controller) *aggregate {
+				aggr := buildRepositoryAccess(ctrl, nil, nil)
+				aggr.repo.(*repo.MockRepository).EXPECT().GetRepositoryConfig(gomock.Any(), "aereal", "example-repo").Times(1).Return(&model.RepositoryConfig{Owner: "aereal", Name: "example-repo", Schedules: &model.MergeChanceSchedules{}}, nil)
+				return aggr
+			},
+		},
+		{
+			name:       "config of the repository no longer visible",
+			statusCode: http.StatusOK,
+			params: graphql.RawParams{
+				Query: "query($owner: String!, $name: String!) {repository(owner: $owner, name: $name){id config{statusContext}}}",
+				Variables: map[string]interface{}{
+					"owner": "aereal",
+					"name":  "example-repo",
+				},
+			},
+			expected: graphql.Response{
+				Data: json.RawMessage(`{"repository":{"id":1234,"config":null}}`),
+				Errors: gqlerror.List{{
+					Message:    "repository aereal/example-repo is not found",
+					Path:       ast.Path{ast.PathName("repository"), ast.PathName("config")},
+					Extensions: map[string]interface{}{"code": string(graph.ErrorCodeNotFound)},
+				}},
+			},
+			build: func(ctrl *gomock.Controller) *aggregate {
+				return buildRepositoryAccess(ctrl, &github.Response{Response: &http.Response{StatusCode: http.StatusNotFound}}, fmt.Errorf("404 Not Found"))
+			},
+		},
+		{
+			name:       "audit log of the repository no longer visible",
+			statusCode: http.StatusOK,
+			params: graphql.RawParams{
+				Query: "query($owner: String!, $name: String!) {repository(owner: $owner, name: $name){id auditLog{nodes{id}}}}",
+				Variables: map[string]interface{}{
+					"owner": "aereal",
+					"name":  "example-repo",
+				},
+			},
+			expected: graphql.Response{
+				Data: json.RawMessage(`{"repository":null}`),
+				Errors: gqlerror.List{{
+					Message:    "repository aereal/example-repo is not found",
+					Path:       ast.Path{ast.PathName("repository"), ast.PathName("auditLog")},
+					Extensions: map[string]interface{}{"code": string(graph.ErrorCodeNotFound)},
+				}},
+			},
+			build: func(ctrl *gomock.Controller) *aggregate {
+				return buildRepositoryAccess(ctrl, &github.Response{Response: &http.Response{StatusCode: http.StatusNotFound}}, fmt.Errorf("404 Not Found"))
+			},
+		},
 	}
 
 	for _, c := range cases {
@@ -81,44 +150,188 @@ func TestQuery(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 
-			aggr := c.build(ctrl)
-			es, err := aggr.executableSchema()
-			if err != nil {
-				t.Fatal(err)
+			got, statusCode := doQuery(t, c.build(ctrl), c.params)
+			if statusCode != c.statusCode {
+				t.Errorf("status code expected=%d got=%d", c.statusCode, statusCode)
 			}
-			w := &Web{
-				authorizer: aggr.authorizer,
-				es:         es,
+			if !reflect.DeepEqual(got, c.expected) {
+				t.Errorf("\nexpected=%#v\n     got=%#v", c.expected, got)
+			}
+		})
+	}
+}
+
+func TestMutation_updateRepositoryConfig(t *testing.T) {
+	paramsWithWindows := func(windows []interface{}) graphql.RawParams {
+		return graphql.RawParams{
+			Query: "mutation($owner: String!, $name: String!, $windows: [MergeChanceWindowToUpdate!]) {updateRepositoryConfig(owner: $owner, name: $name, config: {schedules: {windows: $windows}})}",
+			Variables: map[string]interface{}{
+				"owner":   "aereal",
+				"name":    "example-repo",
+				"windows": windows,
+			},
+		}
+	}
+	permissions := func(perms ...string) *map[string]bool {
+		m := map[string]bool{"admin": false, "maintain": false, "push": false, "triage": false, "pull": false}
+		for _, p := range perms {
+			m[p] = true
+		}
+		return &m
+	}
+	notFound := &github.Response{Response: &http.Response{StatusCode: http.StatusNotFound}}
+	typedError := func(message string, code graph.ErrorCode) graphql.Response {
+		return graphql.Response{
+			Data: json.RawMessage(`null`),
+			Errors: gqlerror.List{{
+				Message:    message,
+				Path:       ast.Path{ast.PathName("updateRepositoryConfig")},
+				Extensions: map[string]interface{}{"code": string(code)},
+			}},
+		}
+	}
+
+	cases := []struct {
+		name                string
+		minConfigPermission model.Permission
+		permissions         *map[string]bool
+		repoResp            *github.Response
+		repoErr             error
+		installResp         *github.Response
+		installErr          error
+		windows             []interface{}
+		invalid             bool
+		conflict            bool
+		updated             bool
+		expected            graphql.Response
+	}{
+		{
+			name:        "admin",
+			permissions: permissions("admin", "maintain", "push", "triage", "pull"),
+			updated:     true,
+			expected:    graphql.Response{Data: json.RawMessage(`{"updateRepositoryConfig":true}`)},
+		},
+		{
+			name:        "write",
+			permissions: permissions("push", "triage", "pull"),
+			expected:    typedError("admin permission on aereal/example-repo is required, but you have write", graph.ErrorCodeForbidden),
+		},
+		{
+			name:                "maintain is sufficient",
+			minConfigPermission: model.PermissionMaintain,
+			permissions:         permissions("maintain", "push", "triage", "pull"),
+			updated:             true,
+			expected:            graphql.Response{Data: json.RawMessage(`{"updateRepositoryConfig":true}`)},
+		},
+		{
+			name:     "repository not visible",
+			repoResp: notFound,
+			repoErr:  fmt.Errorf("404 Not Found"),
+			expected: typedError("repository aereal/example-repo is not found", graph.ErrorCodeNotFound),
+		},
+		{
+			name:        "overnight window",
+			permissions: permissions("admin", "maintain", "push", "triage", "pull"),
+			windows: []interface{}{
+				map[string]interface{}{"weekday": "SATURDAY", "startHour": 22, "stopHour": 2, "stopsNextDay": true},
+			},
+			updated:  true,
+			expected: graphql.Response{Data: json.RawMessage(`{"updateRepositoryConfig":true}`)},
+		},
+		{
+			name:        "invalid windows",
+			permissions: permissions("admin", "maintain", "push", "triage", "pull"),
+			windows: []interface{}{
+				map[string]interface{}{"weekday": "MONDAY", "startHour": 25, "stopHour": 3},
+				map[string]interface{}{"weekday": "FRIDAY", "startHour": 10, "stopHour": 9},
+			},
+			invalid: true,
+			expected: graphql.Response{
+				Data: json.RawMessage(`null`),
+				Errors: gqlerror.List{
+					{
+						Message:    "schedules.monday[0].startHour must be between 0 and 23",
+						Path:       ast.Path{ast.PathName("updateRepositoryConfig")},
+						Extensions: map[string]interface{}{"code": string(graph.ErrorCodeInvalidInput), "field": "schedules.monday[0].startHour"},
+					},
+					{
+						Message:    "schedules.friday[0] must not stop before it starts unless it stops on the next day",
+						Path:       ast.Path{ast.PathName("updateRepositoryConfig")},
+						Extensions: map[string]interface{}{"code": string(graph.ErrorCodeInvalidInput), "field": "schedules.friday[0]"},
+					},
+				},
+			},
+		},
+		{
+			name:        "updated since read",
+			permissions: permissions("admin", "maintain", "push", "triage", "pull"),
+			conflict:    true,
+			expected:    typedError("aereal/example-repo has been updated since it was read; reload it and try again", graph.ErrorCodeConflict),
+		},
+		{
+			name:        "not installed",
+			permissions: permissions("admin", "maintain", "push", "triage", "pull"),
+			installResp: notFound,
+			installErr:  fmt.Errorf("404 Not Found"),
+			expected:    typedError("the app is not installed on aereal/example-repo", graph.ErrorCodeNotInstalled),
+		},
+	}
+	for _, c := range cases {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			minConfigPermission := c.minConfigPermission
+			if minConfigPermission == model.PermissionNone {
+				minConfigPermission = model.PermissionAdmin
 			}
-			srv := httptest.NewServer(w.handler())
-			defer srv.Close()
+			a := authz.NewMockAuthorizer(ctrl)
+			a.EXPECT().Middleware().AnyTimes().Return(func(next http.Handler) http.Handler { return next })
+			a.EXPECT().GetCurrentClaims(gomock.Any()).Times(1).Return(&authz.AppClaims{AccessToken: "0xdeadbeaf"}, nil)
 
-			buf := new(bytes.Buffer)
-			if err := json.NewEncoder(buf).Encode(c.params); err != nil {
-				t.Fatal(err)
+			repoSrv := githubapi.NewMockRepositoriesService(ctrl)
+			repoSrv.EXPECT().Get(gomock.Any(), "aereal", "example-repo").Times(1).
+				Return(&github.Repository{Name: github.String("example-repo"), Permissions: c.permissions}, c.repoResp, c.repoErr)
+			userClient := githubapi.NewMockClient(ctrl)
+			userClient.EXPECT().Repositories().Return(repoSrv)
+			ad := githubapps.NewMockGitHubAppsAdapter(ctrl)
+			ad.EXPECT().NewUserClient(gomock.Any(), "0xdeadbeaf").Times(1).Return(userClient)
+			if c.repoErr == nil && model.PermissionOf(*c.permissions) >= minConfigPermission {
+				apps := githubapi.NewMockAppsService(ctrl)
+				apps.EXPECT().FindRepositoryInstallation(gomock.Any(), "aereal", "example-repo").Times(1).
+					Return(&github.Installation{ID: github.Int64(1234)}, c.installResp, c.installErr)
+				appClient := githubapi.NewMockClient(ctrl)
+				appClient.EXPECT().Apps().Return(apps)
+				ad.EXPECT().NewAppClient().Return(appClient)
 			}
-			req, err := http.NewRequest(http.MethodPost, srv.URL+"/api/query", buf)
-			if err != nil {
-				t.Fatal(err)
+
+			r := repo.NewMockRepository(ctrl)
+			uc := usecase.NewMockUsecase(ctrl)
+			if c.updated || c.invalid || c.conflict {
+				users := githubapi.NewMockUsersService(ctrl)
+				users.EXPECT().Get(gomock.Any(), "").Return(&github.User{Login: github.String("aereal")}, nil, nil)
+				userClient.EXPECT().Users().Return(users)
+				r.EXPECT().GetRepositoryConfig(gomock.Any(), "aereal", "example-repo").Return(&model.RepositoryConfig{Owner: "aereal", Name: "example-repo"}, nil)
 			}
-			req.Header.Set("content-type", "application/json")
-			client := http.DefaultClient
-			resp, err := client.Do(req)
-			if err != nil {
-				t.Fatal(err)
+			if c.conflict {
+				r.EXPECT().UpdateRepositoryConfig(gomock.Any(), gomock.Any()).Return(repo.ErrConflict)
 			}
-			var got graphql.Response
-			if err := json.NewDecoder(resp.Body).Decode(&got); err != nil {
-				t.Fatal(err)
+			if c.updated {
+				r.EXPECT().UpdateRepositoryConfig(gomock.Any(), gomock.Any()).Return(nil)
+				r.EXPECT().AddAuditEvents(gomock.Any(), gomock.Len(1)).Return(nil)
+				uc.EXPECT().MigrateStatusContext(gomock.Any(), ad, gomock.Any(), model.DefaultStatusContext, gomock.Any()).Return(nil)
 			}
-			t.Logf("response body=%#v response=%#v", got, resp)
-			if resp.StatusCode != c.statusCode {
-				t.Errorf("status code expected=%d got=%d", c.statusCode, resp.StatusCode)
+
+			aggr := &aggregate{authorizer: a, adapter: ad, repo: r, usecase: uc, minConfigPermission: minConfigPermission}
+			windows := c.windows
+			if windows == nil {
+				windows = []interface{}{}
 			}
-			for _, e := range got.Errors {
-				t.Log(e)
+			got, statusCode := doQuery(t, aggr, paramsWithWindows(windows))
+			if statusCode != http.StatusOK {
+				t.Errorf("status code expected=%d got=%d", http.StatusOK, statusCode)
 			}
-			t.Logf("got data=%s", string(got.Data))
 			if !reflect.DeepEqual(got, c.expected) {
 				t.Errorf("\nexpected=%#v\n     got=%#v", c.expected, got)
 			}
@@ -126,16 +339,94 @@ func TestQuery(t *testing.T) {
 	}
 }
 
+// buildRepositoryAccess builds the mocks to resolve a repository and check the access to it with the response and the error from GitHub.
+func buildRepositoryAccess(ctrl *gomock.Controller, accessResp *github.Response, accessErr error) *aggregate {
+	a := authz.NewMockAuthorizer(ctrl)
+	a.EXPECT().Middleware().AnyTimes().Return(func(next http.Handler) http.Handler { return next })
+	a.EXPECT().GetCurrentClaims(gomock.Any()).Times(2).Return(&authz.AppClaims{AccessToken: "0xdeadbeaf"}, nil)
+
+	ghRepo := &github.Repository{
+		ID:       github.Int64(1234),
+		Name:     github.String("example-repo"),
+		FullName: github.String("aereal/example-repo"),
+		Owner: &github.User{
+			Login: github.String("aereal"),
+			Type:  github.String("User"),
+		},
+	}
+	repoSrv := githubapi.NewMockRepositoriesService(ctrl)
+	gomock.InOrder(
+		repoSrv.EXPECT().Get(gomock.Any(), "aereal", "example-repo").Return(ghRepo, nil, nil),
+		repoSrv.EXPECT().Get(gomock.Any(), "aereal", "example-repo").Return(ghRepo, accessResp, accessErr),
+	)
+	client := githubapi.NewMockClient(ctrl)
+	client.EXPECT().Repositories().Times(2).Return(repoSrv)
+	ad := githubapps.NewMockGitHubAppsAdapter(ctrl)
+	ad.EXPECT().NewUserClient(gomock.Any(), "0xdeadbeaf").Times(2).Return(client)
+
+	return &aggregate{
+		authorizer: a,
+		adapter:    ad,
+		repo:       repo.NewMockRepository(ctrl),
+		usecase:    usecase.NewMockUsecase(ctrl),
+	}
+}
+
+// doQuery sends the GraphQL request to the API served with the mocks of aggr.
+func doQuery(t *testing.T, aggr *aggregate, params graphql.RawParams) (graphql.Response, int) {
+	t.Helper()
+	es, err := aggr.executableSchema()
+	if err != nil {
+		t.Fatal(err)
+	}
+	w := &Web{
+		authorizer: aggr.authorizer,
+		es:         es,
+	}
+	srv := httptest.NewServer(w.handler())
+	defer srv.Close()
+
+	buf := new(bytes.Buffer)
+	if err := json.NewEncoder(buf).Encode(params); err != nil {
+		t.Fatal(err)
+	}
+	req, err := http.NewRequest(http.MethodPost, srv.URL+"/api/query", buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("content-type", "application/json")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	var got graphql.Response
+	if err := json.NewDecoder(resp.Body).Decode(&got); err != nil {
+		t.Fatal(err)
+	}
+	for _, e := range got.Errors {
+		t.Log(e)
+	}
+	t.Logf("got data=%s", string(got.Data))
+	return got, resp.StatusCode
+}
+
 type aggregate struct {
-	authorizer authz.Authorizer
-	adapter    githubapps.GitHubAppsAdapter
-	repo       repo.Repository
+	authorizer          authz.Authorizer
+	adapter             githubapps.GitHubAppsAdapter
+	repo                repo.Repository
+	usecase             usecase.Usecase
+	minConfigPermission model.Permission
 }
 
 func (a aggregate) executableSchema() (graphql.ExecutableSchema, error) {
-	res, err := graph.New(a.authorizer, a.adapter, a.repo)
+	minConfigPermission := a.minConfigPermission
+	if minConfigPermission == model.PermissionNone {
+		minConfigPermission = model.PermissionAdmin
+	}
+	res, err := graph.New(a.authorizer, a.adapter, a.repo, a.usecase, minConfigPermission)
 	if err != nil {
 		return nil, err
 	}
-	return generated.NewExecutableSchema(generated.Config{Resolvers: res}), nil
+	return generated.NewExecutableSchema(generated.Config{Resolvers: res, Directives: res.Directives()}), nil
 }
@@ -0,0 +1,144 @@
+package web
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aereal/merge-chance-time/app/authz"
+	"github.com/aereal/merge-chance-time/ical"
+	"github.com/aereal/merge-chance-time/usecase"
+	"github.com/golang/mock/gomock"
+)
+
+func TestCalendarFeed(t *testing.T) {
+	cases := []struct {
+		name           string
+		statusCode     int
+		path           string
+		buildAuthz     func(ctrl *gomock.Controller) authz.Authorizer
+		buildUsecase   func(ctrl *gomock.Controller) usecase.Usecase
+		bodyContaining string
+	}{
+		{
+			name:       "ok",
+			statusCode: http.StatusOK,
+			path:       "/feeds/aereal/example-repo/merge-chances.ics?token=0xdeadbeaf",
+			buildAuthz: func(ctrl *gomock.Controller) authz.Authorizer {
+				a := authz.NewMockAuthorizer(ctrl)
+				a.EXPECT().Middleware().AnyTimes().Return(func(next http.Handler) http.Handler { return next })
+				a.EXPECT().AuthenticateFeedToken("0xdeadbeaf").Times(1).Return(&authz.FeedClaims{Owner: "aereal", Name: "example-repo"}, nil)
+				return a
+			},
+			buildUsecase: func(ctrl *gomock.Controller) usecase.Usecase {
+				uc := usecase.NewMockUsecase(ctrl)
+				uc.EXPECT().CalendarFeed(gomock.Any(), "aereal", "example-repo", 0, gomock.Any()).Times(1).Return(&ical.Calendar{
+					Events: []*ical.Event{
+						{
+							UID:   "1@example.com",
+							Start: time.Date(2020, time.February, 3, 10, 0, 0, 0, time.UTC),
+							End:   time.Date(2020, time.February, 3, 18, 0, 0, 0, time.UTC),
+							RRule: "FREQ=WEEKLY",
+						},
+					},
+				}, nil)
+				return uc
+			},
+			bodyContaining: "RRULE:FREQ=WEEKLY\r\n",
+		},
+		{
+			name:       "token for another repository",
+			statusCode: http.StatusUnauthorized,
+			path:       "/feeds/aereal/example-repo/merge-chances.ics?token=0xdeadbeaf",
+			buildAuthz: func(ctrl *gomock.Controller) authz.Authorizer {
+				a := authz.NewMockAuthorizer(ctrl)
+				a.EXPECT().Middleware().AnyTimes().Return(func(next http.Handler) http.Handler { return next })
+				a.EXPECT().AuthenticateFeedToken("0xdeadbeaf").Times(1).Return(&authz.FeedClaims{Owner: "aereal", Name: "another-repo"}, nil)
+				return a
+			},
+			buildUsecase: func(ctrl *gomock.Controller) usecase.Usecase {
+				return usecase.NewMockUsecase(ctrl)
+			},
+		},
+		{
+			name:       "invalid token",
+			statusCode: http.StatusUnauthorized,
+			path:       "/feeds/aereal/example-repo/merge-chances.ics",
+			buildAuthz: func(ctrl *gomock.Controller) authz.Authorizer {
+				a := authz.NewMockAuthorizer(ctrl)
+				a.EXPECT().Middleware().AnyTimes().Return(func(next http.Handler) http.Handler { return next })
+				a.EXPECT().AuthenticateFeedToken("").Times(1).Return(nil, fmt.Errorf("invalid"))
+				return a
+			},
+			buildUsecase: func(ctrl *gomock.Controller) usecase.Usecase {
+				return usecase.NewMockUsecase(ctrl)
+			},
+		},
+		{
+			name:       "config not found",
+			statusCode: http.StatusNotFound,
+			path:       "/feeds/aereal/example-repo/merge-chances.ics?token=0xdeadbeaf",
+			buildAuthz: func(ctrl *gomock.Controller) authz.Authorizer {
+				a := authz.NewMockAuthorizer(ctrl)
+				a.EXPECT().Middleware().AnyTimes().Return(func(next http.Handler) http.Handler { return next })
+				a.EXPECT().AuthenticateFeedToken("0xdeadbeaf").Times(1).Return(&authz.FeedClaims{Owner: "aereal", Name: "example-repo"}, nil)
+				return a
+			},
+			buildUsecase: func(ctrl *gomock.Controller) usecase.Usecase {
+				uc := usecase.NewMockUsecase(ctrl)
+				uc.EXPECT().CalendarFeed(gomock.Any(), "aereal", "example-repo", 0, gomock.Any()).Times(1).Return(nil, usecase.ErrConfigNotFound)
+				return uc
+			},
+		},
+		{
+			name:       "revoked token",
+			statusCode: http.StatusUnauthorized,
+			path:       "/feeds/aereal/example-repo/merge-chances.ics?token=0xdeadbeaf",
+			buildAuthz: func(ctrl *gomock.Controller) authz.Authorizer {
+				a := authz.NewMockAuthorizer(ctrl)
+				a.EXPECT().Middleware().AnyTimes().Return(func(next http.Handler) http.Handler { return next })
+				a.EXPECT().AuthenticateFeedToken("0xdeadbeaf").Times(1).Return(&authz.FeedClaims{Owner: "aereal", Name: "example-repo", Generation: 1}, nil)
+				return a
+			},
+			buildUsecase: func(ctrl *gomock.Controller) usecase.Usecase {
+				uc := usecase.NewMockUsecase(ctrl)
+				uc.EXPECT().CalendarFeed(gomock.Any(), "aereal", "example-repo", 1, gomock.Any()).Times(1).Return(nil, usecase.ErrFeedRevoked)
+				return uc
+			},
+		},
+	}
+	for _, c := range cases {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			w := &Web{
+				authorizer: c.buildAuthz(ctrl),
+				usecase:    c.buildUsecase(ctrl),
+			}
+			srv := httptest.NewServer(w.handler())
+			defer srv.Close()
+
+			resp, err := http.Get(srv.URL + c.path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer resp.Body.Close()
+			body, err := ioutil.ReadAll(resp.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if resp.StatusCode != c.statusCode {
+				t.Errorf("status code expected=%d got=%d body=%s", c.statusCode, resp.StatusCode, body)
+			}
+			if !strings.Contains(string(body), c.bodyContaining) {
+				t.Errorf("body expected to contain %q; got=%s", c.bodyContaining, body)
+			}
+		})
+	}
+}
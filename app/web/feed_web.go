@@ -0,0 +1,58 @@
+package web
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/aereal/merge-chance-time/ical"
+	"github.com/aereal/merge-chance-time/logging"
+	"github.com/aereal/merge-chance-time/usecase"
+	"github.com/dimfeld/httptreemux/v5"
+)
+
+func (c *Web) handleGetCalendarFeed() http.HandlerFunc {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		logger := logging.GetLogger(ctx)
+		params := httptreemux.ContextParams(ctx)
+		owner, name := params["owner"], params["name"]
+
+		claims, err := c.authorizer.AuthenticateFeedToken(r.URL.Query().Get("token"))
+		if err != nil || claims.Owner != owner || claims.Name != name {
+			logger.Warnf("invalid feed token: owner=%s name=%s error=%v", owner, name, err)
+			w.Header().Set("content-type", "application/json")
+			w.WriteHeader(http.StatusUnauthorized)
+			json.NewEncoder(w).Encode(struct{ Error string }{"invalid token"})
+			return
+		}
+
+		now := time.Now()
+		calendar, err := c.usecase.CalendarFeed(ctx, owner, name, claims.Generation, now)
+		if err == usecase.ErrFeedRevoked {
+			w.Header().Set("content-type", "application/json")
+			w.WriteHeader(http.StatusUnauthorized)
+			json.NewEncoder(w).Encode(struct{ Error string }{"invalid token"})
+			return
+		}
+		if err == usecase.ErrConfigNotFound {
+			w.Header().Set("content-type", "application/json")
+			w.WriteHeader(http.StatusNotFound)
+			json.NewEncoder(w).Encode(struct{ Error string }{err.Error()})
+			return
+		}
+		if err != nil {
+			logger.Errorf("CalendarFeed: %v", err)
+			w.Header().Set("content-type", "application/json")
+			w.WriteHeader(http.StatusInternalServerError)
+			json.NewEncoder(w).Encode(struct{ Error string }{err.Error()})
+			return
+		}
+
+		w.Header().Set("content-type", "text/calendar; charset=utf-8")
+		w.Header().Set("cache-control", "private, max-age=300")
+		if err := ical.Encode(w, calendar, now); err != nil {
+			logger.Errorf("Encode: %v", err)
+		}
+	})
+}
@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/aereal/merge-chance-time/domain/service"
 	"github.com/aereal/merge-chance-time/logging"
 	"github.com/aereal/merge-chance-time/usecase"
 	"github.com/google/go-github/v30/github"
@@ -75,7 +76,7 @@ func (c *Web) handleCron() http.HandlerFunc {
 		logger.Infof("payload.subscription=%q payload.message.id=%q publishTime=%q data=%q", payload.Subscription, payload.Message.ID, payload.Message.PublishTime, string(payload.Message.Data))
 
 		baseTime := time.Time(payload.Message.PublishTime)
-		err := c.usecase.UpdateChanceTime(ctx, c.ghAdapter, baseTime)
+		summary, err := c.usecase.UpdateChanceTime(ctx, c.ghAdapter, baseTime)
 		if err != nil {
 			w.WriteHeader(http.StatusInternalServerError)
 			w.Header().Set("content-type", "application/json")
@@ -83,8 +84,14 @@ func (c *Web) handleCron() http.HandlerFunc {
 			json.NewEncoder(w).Encode(struct{ Error string }{err.Error()})
 			return
 		}
+		if err := summary.Err(); err != nil {
+			// the failed repositories are retried on the next tick, so the message is acknowledged not to redeliver it to every repository
+			logger.Error(err.Error())
+		}
 
-		w.WriteHeader(http.StatusNoContent)
+		w.Header().Set("content-type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(summary)
 	})
 }
 
@@ -131,20 +138,21 @@ func (c *Web) handleWebhook() http.HandlerFunc {
 		case *github.InstallationRepositoriesEvent:
 			c.onRepositoryInstallation(w, r, p)
 		case *github.PullRequestEvent:
-			c.onPullRequest(w, r, p)
+			c.onPullRequest(w, r, p, payloadBytes)
+		case *github.CheckRunEvent:
+			c.onCheckRun(w, r, p)
 		default:
 			w.WriteHeader(http.StatusNoContent)
 		}
 	})
 }
 
-func (c *Web) onPullRequest(w http.ResponseWriter, r *http.Request, payload *github.PullRequestEvent) {
+func (c *Web) onPullRequest(w http.ResponseWriter, r *http.Request, payload *github.PullRequestEvent, payloadBytes []byte) {
 	ctx := r.Context()
 	logger := logging.GetLogger(ctx)
 	logger.Infof("Pull Request Event: %#v", payload)
-	action := payload.GetAction()
-	if action != "opened" && action != "synchronize" {
-		logger.Warnf("Received action is %q skipping", action)
+	if !shouldUpdateCommitStatus(payload.GetAction(), payloadBytes) {
+		logger.Warnf("Received action is %q skipping", payload.GetAction())
 		w.WriteHeader(http.StatusNoContent)
 		return
 	}
@@ -166,6 +174,54 @@ func (c *Web) onPullRequest(w http.ResponseWriter, r *http.Request, payload *git
 	w.WriteHeader(http.StatusNoContent)
 }
 
+// onCheckRun handles actions requested on the check runs created by the app.
+func (c *Web) onCheckRun(w http.ResponseWriter, r *http.Request, payload *github.CheckRunEvent) {
+	ctx := r.Context()
+	logger := logging.GetLogger(ctx)
+	logger.Infof("Check Run Event: %#v", payload)
+	if payload.GetAction() != "requested_action" || payload.RequestedAction == nil || payload.RequestedAction.Identifier != service.RequestOverrideActionID {
+		logger.Warnf("Received action is %q skipping", payload.GetAction())
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	ghClient := c.ghAdapter.NewInstallationClient(payload.Installation.GetID())
+
+	err := c.usecase.RequestOverride(ctx, ghClient, payload.GetRepo(), payload.GetCheckRun(), payload.GetSender().GetLogin())
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Header().Set("content-type", "application/json")
+		json.NewEncoder(w).Encode(struct{ Error string }{err.Error()})
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// shouldUpdateCommitStatus reports whether the pull request event may change the commit status the pull request should have.
+func shouldUpdateCommitStatus(action string, payloadBytes []byte) bool {
+	switch action {
+	case "opened", "reopened", "synchronize", "ready_for_review", "labeled", "unlabeled":
+		return true
+	case "edited":
+		return baseChanged(payloadBytes)
+	default:
+		return false
+	}
+}
+
+// baseChanged reports whether the edited event changes the base branch.
+// github.EditChange does not have the base field, so it is read from the raw payload.
+func baseChanged(payloadBytes []byte) bool {
+	var payload struct {
+		Changes struct {
+			Base *json.RawMessage `json:"base"`
+		} `json:"changes"`
+	}
+	if err := json.Unmarshal(payloadBytes, &payload); err != nil {
+		return false
+	}
+	return payload.Changes.Base != nil
+}
+
 func (c *Web) onInstallation(w http.ResponseWriter, r *http.Request, payload *github.InstallationEvent) {
 	ctx := r.Context()
 	logger := logging.GetLogger(ctx)
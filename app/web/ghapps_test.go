@@ -30,6 +30,7 @@ func TestCron(t *testing.T) {
 		reqBody      interface{}
 		statusCode   int
 		buildUsecase func(ctrl *gomock.Controller) usecase.Usecase
+		wantBody     string
 	}{
 		{
 			name: "ok",
@@ -41,10 +42,50 @@ func TestCron(t *testing.T) {
 					PublishTime: PublishTime(now),
 				},
 			},
-			statusCode: http.StatusNoContent,
+			statusCode: http.StatusOK,
+			buildUsecase: func(ctrl *gomock.Controller) usecase.Usecase {
+				uc := usecase.NewMockUsecase(ctrl)
+				uc.EXPECT().UpdateChanceTime(gomock.Any(), gomock.Any(), eqTime(now)).Return(&usecase.UpdateChanceTimeSummary{Updated: []string{"aereal/example-repo"}}, nil).AnyTimes()
+				return uc
+			},
+			wantBody: `{"updated":["aereal/example-repo"],"quarantined":null,"released":null,"deleted":null,"failures":null}`,
+		},
+		{
+			name: "some repositories failed",
+			reqBody: &PubSubPayload{
+				Subscription: "0xdeadbeaf",
+				Message: &PubSubMessage{
+					Data:        json.RawMessage("{}"),
+					ID:          "xxx",
+					PublishTime: PublishTime(now),
+				},
+			},
+			statusCode: http.StatusOK,
 			buildUsecase: func(ctrl *gomock.Controller) usecase.Usecase {
 				uc := usecase.NewMockUsecase(ctrl)
-				uc.EXPECT().UpdateChanceTime(gomock.Any(), gomock.Any(), eqTime(now)).AnyTimes()
+				summary := &usecase.UpdateChanceTimeSummary{
+					Quarantined: []string{"orphan/example-repo"},
+					Failures:    []*usecase.RepositoryFailure{{Repository: "aereal/example-repo", Error: "oops"}},
+				}
+				uc.EXPECT().UpdateChanceTime(gomock.Any(), gomock.Any(), eqTime(now)).Return(summary, nil).AnyTimes()
+				return uc
+			},
+			wantBody: `{"updated":null,"quarantined":["orphan/example-repo"],"released":null,"deleted":null,"failures":[{"repository":"aereal/example-repo","error":"oops"}]}`,
+		},
+		{
+			name: "failed",
+			reqBody: &PubSubPayload{
+				Subscription: "0xdeadbeaf",
+				Message: &PubSubMessage{
+					Data:        json.RawMessage("{}"),
+					ID:          "xxx",
+					PublishTime: PublishTime(now),
+				},
+			},
+			statusCode: http.StatusInternalServerError,
+			buildUsecase: func(ctrl *gomock.Controller) usecase.Usecase {
+				uc := usecase.NewMockUsecase(ctrl)
+				uc.EXPECT().UpdateChanceTime(gomock.Any(), gomock.Any(), eqTime(now)).Return(nil, fmt.Errorf("oops")).AnyTimes()
 				return uc
 			},
 		},
@@ -92,6 +133,12 @@ func TestCron(t *testing.T) {
 			if resp.StatusCode != c.statusCode {
 				t.Errorf("status code expected=%d got=%d", c.statusCode, resp.StatusCode)
 			}
+			if c.wantBody != "" {
+				body, _ := ioutil.ReadAll(resp.Body)
+				if got := strings.TrimSpace(string(body)); got != c.wantBody {
+					t.Errorf("body expected=%s got=%s", c.wantBody, got)
+				}
+			}
 		})
 	}
 }
@@ -301,6 +348,176 @@ func TestWebhook(t *testing.T) {
 				return uc
 			},
 		},
+		{
+			name:      "pull_request labeled",
+			eventType: "pull_request",
+			reqBody: &github.PullRequestEvent{
+				Action: stringRef("labeled"),
+				Installation: &github.Installation{
+					ID: int64ref(1234),
+				},
+				PullRequest: &github.PullRequest{},
+			},
+			statusCode: http.StatusNoContent,
+			buildGhAdapter: func(ctrl *gomock.Controller) githubapps.GitHubAppsAdapter {
+				a := githubapps.NewMockGitHubAppsAdapter(ctrl)
+				a.EXPECT().NewInstallationClient(gomock.Eq(int64(1234))).Times(1)
+				return a
+			},
+			buildUsecase: func(ctrl *gomock.Controller) usecase.Usecase {
+				uc := usecase.NewMockUsecase(ctrl)
+				uc.EXPECT().
+					UpdatePullRequestCommitStatus(gomock.Any(), gomock.Any(), gomock.Eq(&github.PullRequest{})).
+					Return(nil).
+					Times(1)
+				return uc
+			},
+		},
+		{
+			name:      "pull_request unlabeled",
+			eventType: "pull_request",
+			reqBody: &github.PullRequestEvent{
+				Action: stringRef("unlabeled"),
+				Installation: &github.Installation{
+					ID: int64ref(1234),
+				},
+				PullRequest: &github.PullRequest{},
+			},
+			statusCode: http.StatusNoContent,
+			buildGhAdapter: func(ctrl *gomock.Controller) githubapps.GitHubAppsAdapter {
+				a := githubapps.NewMockGitHubAppsAdapter(ctrl)
+				a.EXPECT().NewInstallationClient(gomock.Eq(int64(1234))).Times(1)
+				return a
+			},
+			buildUsecase: func(ctrl *gomock.Controller) usecase.Usecase {
+				uc := usecase.NewMockUsecase(ctrl)
+				uc.EXPECT().
+					UpdatePullRequestCommitStatus(gomock.Any(), gomock.Any(), gomock.Eq(&github.PullRequest{})).
+					Return(nil).
+					Times(1)
+				return uc
+			},
+		},
+		{
+			name:      "pull_request reopened",
+			eventType: "pull_request",
+			reqBody: &github.PullRequestEvent{
+				Action: stringRef("reopened"),
+				Installation: &github.Installation{
+					ID: int64ref(1234),
+				},
+				PullRequest: &github.PullRequest{},
+			},
+			statusCode: http.StatusNoContent,
+			buildGhAdapter: func(ctrl *gomock.Controller) githubapps.GitHubAppsAdapter {
+				a := githubapps.NewMockGitHubAppsAdapter(ctrl)
+				a.EXPECT().NewInstallationClient(gomock.Eq(int64(1234))).Times(1)
+				return a
+			},
+			buildUsecase: func(ctrl *gomock.Controller) usecase.Usecase {
+				uc := usecase.NewMockUsecase(ctrl)
+				uc.EXPECT().
+					UpdatePullRequestCommitStatus(gomock.Any(), gomock.Any(), gomock.Eq(&github.PullRequest{})).
+					Return(nil).
+					Times(1)
+				return uc
+			},
+		},
+		{
+			name:      "pull_request ready_for_review",
+			eventType: "pull_request",
+			reqBody: &github.PullRequestEvent{
+				Action: stringRef("ready_for_review"),
+				Installation: &github.Installation{
+					ID: int64ref(1234),
+				},
+				PullRequest: &github.PullRequest{},
+			},
+			statusCode: http.StatusNoContent,
+			buildGhAdapter: func(ctrl *gomock.Controller) githubapps.GitHubAppsAdapter {
+				a := githubapps.NewMockGitHubAppsAdapter(ctrl)
+				a.EXPECT().NewInstallationClient(gomock.Eq(int64(1234))).Times(1)
+				return a
+			},
+			buildUsecase: func(ctrl *gomock.Controller) usecase.Usecase {
+				uc := usecase.NewMockUsecase(ctrl)
+				uc.EXPECT().
+					UpdatePullRequestCommitStatus(gomock.Any(), gomock.Any(), gomock.Eq(&github.PullRequest{})).
+					Return(nil).
+					Times(1)
+				return uc
+			},
+		},
+		{
+			name:      "pull_request edited / base changed",
+			eventType: "pull_request",
+			reqBody: map[string]interface{}{
+				"action":       "edited",
+				"installation": map[string]interface{}{"id": 1234},
+				"pull_request": map[string]interface{}{},
+				"changes": map[string]interface{}{
+					"base": map[string]interface{}{
+						"ref": map[string]interface{}{"from": "develop"},
+						"sha": map[string]interface{}{"from": "0xdeadbeaf"},
+					},
+				},
+			},
+			statusCode: http.StatusNoContent,
+			buildGhAdapter: func(ctrl *gomock.Controller) githubapps.GitHubAppsAdapter {
+				a := githubapps.NewMockGitHubAppsAdapter(ctrl)
+				a.EXPECT().NewInstallationClient(gomock.Eq(int64(1234))).Times(1)
+				return a
+			},
+			buildUsecase: func(ctrl *gomock.Controller) usecase.Usecase {
+				uc := usecase.NewMockUsecase(ctrl)
+				uc.EXPECT().
+					UpdatePullRequestCommitStatus(gomock.Any(), gomock.Any(), gomock.Eq(&github.PullRequest{})).
+					Return(nil).
+					Times(1)
+				return uc
+			},
+		},
+		{
+			name:      "pull_request edited / title changed",
+			eventType: "pull_request",
+			reqBody: map[string]interface{}{
+				"action":       "edited",
+				"installation": map[string]interface{}{"id": 1234},
+				"pull_request": map[string]interface{}{},
+				"changes": map[string]interface{}{
+					"title": map[string]interface{}{"from": "WIP"},
+				},
+			},
+			statusCode: http.StatusNoContent,
+			buildGhAdapter: func(ctrl *gomock.Controller) githubapps.GitHubAppsAdapter {
+				a := githubapps.NewMockGitHubAppsAdapter(ctrl)
+				return a
+			},
+			buildUsecase: func(ctrl *gomock.Controller) usecase.Usecase {
+				uc := usecase.NewMockUsecase(ctrl)
+				return uc
+			},
+		},
+		{
+			name:      "pull_request converted_to_draft",
+			eventType: "pull_request",
+			reqBody: &github.PullRequestEvent{
+				Action: stringRef("converted_to_draft"),
+				Installation: &github.Installation{
+					ID: int64ref(1234),
+				},
+				PullRequest: &github.PullRequest{},
+			},
+			statusCode: http.StatusNoContent,
+			buildGhAdapter: func(ctrl *gomock.Controller) githubapps.GitHubAppsAdapter {
+				a := githubapps.NewMockGitHubAppsAdapter(ctrl)
+				return a
+			},
+			buildUsecase: func(ctrl *gomock.Controller) usecase.Usecase {
+				uc := usecase.NewMockUsecase(ctrl)
+				return uc
+			},
+		},
 		{
 			name:      "pull_request closed",
 			eventType: "pull_request",
@@ -322,6 +539,77 @@ func TestWebhook(t *testing.T) {
 			},
 		},
 
+		// check_run
+		{
+			name:      "check_run requested_action",
+			eventType: "check_run",
+			reqBody: &github.CheckRunEvent{
+				Action: stringRef("requested_action"),
+				Installation: &github.Installation{
+					ID: int64ref(1234),
+				},
+				Repo:            &github.Repository{FullName: stringRef("aereal/example-repo")},
+				CheckRun:        &github.CheckRun{ID: int64ref(42)},
+				Sender:          &github.User{Login: stringRef("octocat")},
+				RequestedAction: &github.RequestedAction{Identifier: "request_override"},
+			},
+			statusCode: http.StatusNoContent,
+			buildGhAdapter: func(ctrl *gomock.Controller) githubapps.GitHubAppsAdapter {
+				a := githubapps.NewMockGitHubAppsAdapter(ctrl)
+				a.EXPECT().NewInstallationClient(gomock.Eq(int64(1234))).Times(1)
+				return a
+			},
+			buildUsecase: func(ctrl *gomock.Controller) usecase.Usecase {
+				uc := usecase.NewMockUsecase(ctrl)
+				uc.EXPECT().
+					RequestOverride(gomock.Any(), gomock.Any(), gomock.Eq(&github.Repository{FullName: stringRef("aereal/example-repo")}), gomock.Eq(&github.CheckRun{ID: int64ref(42)}), "octocat").
+					Return(nil).
+					Times(1)
+				return uc
+			},
+		},
+		{
+			name:      "check_run requested_action / unknown action",
+			eventType: "check_run",
+			reqBody: &github.CheckRunEvent{
+				Action: stringRef("requested_action"),
+				Installation: &github.Installation{
+					ID: int64ref(1234),
+				},
+				CheckRun:        &github.CheckRun{ID: int64ref(42)},
+				RequestedAction: &github.RequestedAction{Identifier: "unknown"},
+			},
+			statusCode: http.StatusNoContent,
+			buildGhAdapter: func(ctrl *gomock.Controller) githubapps.GitHubAppsAdapter {
+				a := githubapps.NewMockGitHubAppsAdapter(ctrl)
+				return a
+			},
+			buildUsecase: func(ctrl *gomock.Controller) usecase.Usecase {
+				uc := usecase.NewMockUsecase(ctrl)
+				return uc
+			},
+		},
+		{
+			name:      "check_run created",
+			eventType: "check_run",
+			reqBody: &github.CheckRunEvent{
+				Action: stringRef("created"),
+				Installation: &github.Installation{
+					ID: int64ref(1234),
+				},
+				CheckRun: &github.CheckRun{ID: int64ref(42)},
+			},
+			statusCode: http.StatusNoContent,
+			buildGhAdapter: func(ctrl *gomock.Controller) githubapps.GitHubAppsAdapter {
+				a := githubapps.NewMockGitHubAppsAdapter(ctrl)
+				return a
+			},
+			buildUsecase: func(ctrl *gomock.Controller) usecase.Usecase {
+				uc := usecase.NewMockUsecase(ctrl)
+				return uc
+			},
+		},
+
 		// others
 		{
 			name:      "integration_installation",
@@ -95,6 +95,9 @@ func (w *Web) handler() http.Handler {
 	auth.GET("/start", w.handleGetAuthStart())
 	auth.GET("/callback", w.handleGetAuthCallback())
 
+	feeds := router.UsingContext().NewContextGroup("/feeds")
+	feeds.GET("/:owner/:name/merge-chances.ics", w.handleGetCalendarFeed())
+
 	srv := w.newHandler()
 	apiGroup := router.UsingContext().NewContextGroup("/api")
 	apiGroup.UseHandler(w.authorizer.Middleware())
@@ -2,27 +2,86 @@ package model
 
 import (
 	"fmt"
+	"path"
+	"reflect"
+	"sort"
+	"strings"
 	"time"
 )
 
 type MergeChanceSchedule struct {
-	StartHour int
-	StopHour  int
+	StartHour   int
+	StartMinute int
+	StopHour    int
+	StopMinute  int
+	// StopsNextDay reports whether the schedule stops on the day after it starts (e.g. Friday 22:00 to Saturday 02:00).
+	StopsNextDay bool
 }
 
-var WholeDay = &MergeChanceSchedule{StartHour: 0, StopHour: 23}
+// StartOn returns the instant the schedule starts on the date of t in the location of t.
+func (s *MergeChanceSchedule) StartOn(t time.Time) time.Time {
+	return wallClock(t, s.StartHour, s.StartMinute)
+}
+
+// StopOn returns the instant the schedule starting on the date of t stops in the location of t.
+func (s *MergeChanceSchedule) StopOn(t time.Time) time.Time {
+	if s.StopsNextDay {
+		return wallClock(noonAfter(t, 1), s.StopHour, s.StopMinute)
+	}
+	return wallClock(t, s.StopHour, s.StopMinute)
+}
 
+// WholeDay is the schedule open from the midnight of the day to the midnight of the next day.
+var WholeDay = &MergeChanceSchedule{StartHour: 0, StopHour: 0, StopsNextDay: true}
+
+// Includes reports whether t is between the start and the stop of the schedule starting on the date of t.
+func (s *MergeChanceSchedule) Includes(t time.Time) bool {
+	return s.includesStartedOn(t, t)
+}
+
+// includesStartedOn reports whether t is between the start and the stop of the schedule starting on the date of day.
+func (s *MergeChanceSchedule) includesStartedOn(day, t time.Time) bool {
+	return !t.Before(s.StartOn(day)) && t.Before(s.StopOn(day))
+}
+
+// minutesOfDay returns the start and the stop in minutes from the midnight of the day the schedule starts on.
+// The stop exceeds a day if the schedule stops on the next day.
+func (s *MergeChanceSchedule) minutesOfDay() (start int, stop int) {
+	start, stop = s.StartHour*60+s.StartMinute, s.StopHour*60+s.StopMinute
+	if s.StopsNextDay {
+		stop += minutesPerDay
+	}
+	return start, stop
+}
+
+const (
+	minutesPerDay  = 24 * 60
+	minutesPerWeek = 7 * minutesPerDay
+)
+
+// noonAfter returns the noon of the date days after the date of t in the location of t.
+// Noon always exists, so the date is not shifted by DST transitions.
+func noonAfter(t time.Time, days int) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day()+days, 12, 0, 0, 0, t.Location())
+}
+
+// MergeChanceSchedules holds the schedules of each weekday.
+// A weekday may have several schedules that must not overlap each other.
 type MergeChanceSchedules struct {
-	Sunday    *MergeChanceSchedule
-	Monday    *MergeChanceSchedule
-	Tuesday   *MergeChanceSchedule
-	Wednesday *MergeChanceSchedule
-	Thursday  *MergeChanceSchedule
-	Friday    *MergeChanceSchedule
-	Saturday  *MergeChanceSchedule
+	Sunday    []*MergeChanceSchedule
+	Monday    []*MergeChanceSchedule
+	Tuesday   []*MergeChanceSchedule
+	Wednesday []*MergeChanceSchedule
+	Thursday  []*MergeChanceSchedule
+	Friday    []*MergeChanceSchedule
+	Saturday  []*MergeChanceSchedule
 }
 
-func (s *MergeChanceSchedules) ForWeekday(wd time.Weekday) *MergeChanceSchedule {
+// ForWeekday returns the schedules of the weekday. A nil receiver has no schedules.
+func (s *MergeChanceSchedules) ForWeekday(wd time.Weekday) []*MergeChanceSchedule {
+	if s == nil {
+		return nil
+	}
 	switch wd {
 	case time.Sunday:
 		return s.Sunday
@@ -43,41 +102,796 @@ func (s *MergeChanceSchedules) ForWeekday(wd time.Weekday) *MergeChanceSchedule
 	}
 }
 
+// Add appends the schedule to the schedules of the weekday.
+func (s *MergeChanceSchedules) Add(wd time.Weekday, schedule *MergeChanceSchedule) {
+	switch wd {
+	case time.Sunday:
+		s.Sunday = append(s.Sunday, schedule)
+	case time.Monday:
+		s.Monday = append(s.Monday, schedule)
+	case time.Tuesday:
+		s.Tuesday = append(s.Tuesday, schedule)
+	case time.Wednesday:
+		s.Wednesday = append(s.Wednesday, schedule)
+	case time.Thursday:
+		s.Thursday = append(s.Thursday, schedule)
+	case time.Friday:
+		s.Friday = append(s.Friday, schedule)
+	case time.Saturday:
+		s.Saturday = append(s.Saturday, schedule)
+	}
+}
+
+// validate reports the violations of the schedule at the field.
+// The hours must be in 0..23, the minutes in 0..59, and the start must be before the stop.
+// A schedule stopping on the next day must not last longer than a day.
+func (s *MergeChanceSchedule) validate(field string, errs *fieldErrors) bool {
+	valid := true
+	for _, f := range []struct {
+		name  string
+		value int
+		max   int
+	}{
+		{"startHour", s.StartHour, 23},
+		{"startMinute", s.StartMinute, 59},
+		{"stopHour", s.StopHour, 23},
+		{"stopMinute", s.StopMinute, 59},
+	} {
+		if f.value < 0 || f.value > f.max {
+			errs.add(field+"."+f.name, "must be between 0 and %d", f.max)
+			valid = false
+		}
+	}
+	if !valid {
+		return false
+	}
+	start, stop := s.minutesOfDay()
+	switch {
+	case start == stop:
+		errs.add(field, "must not start and stop at the same time")
+		return false
+	case start > stop:
+		errs.add(field, "must not stop before it starts unless it stops on the next day")
+		return false
+	case stop-start > minutesPerDay:
+		errs.add(field, "must not last longer than a day")
+		return false
+	}
+	return true
+}
+
+// validate reports the violations of the schedules at the field.
+// Each schedule must be valid and must not overlap the other schedules, including the ones stopping on the next day
+// and the ones on Saturday stopping on Sunday.
+func (s *MergeChanceSchedules) validate(field string, errs *fieldErrors) {
+	type window struct {
+		field       string
+		start, stop int // in minutes from the start of the week
+	}
+	windows := []window{}
+	for wd := time.Sunday; wd <= time.Saturday; wd++ {
+		for i, schedule := range s.ForWeekday(wd) {
+			f := fmt.Sprintf("%s.%s[%d]", field, strings.ToLower(wd.String()), i)
+			if schedule.validate(f, errs) {
+				start, stop := schedule.minutesOfDay()
+				offset := int(wd) * minutesPerDay
+				windows = append(windows, window{field: f, start: offset + start, stop: offset + stop})
+			}
+		}
+	}
+	sort.SliceStable(windows, func(i, j int) bool { return windows[i].start < windows[j].start })
+	for i := 1; i < len(windows); i++ {
+		if windows[i].start < windows[i-1].stop {
+			errs.add(windows[i].field, "overlaps %s", windows[i-1].field)
+		}
+	}
+	if n := len(windows); n > 1 && windows[n-1].stop-minutesPerWeek > windows[0].start {
+		errs.add(windows[0].field, "overlaps %s", windows[n-1].field)
+	}
+}
+
+// Date is a calendar date independent of any time zone.
+type Date struct {
+	Year  int
+	Month time.Month
+	Day   int
+}
+
+const dateLayout = "2006-01-02"
+
+// ParseDate parses a date formatted as YYYY-MM-DD.
+func ParseDate(s string) (Date, error) {
+	t, err := time.Parse(dateLayout, s)
+	if err != nil {
+		return Date{}, fmt.Errorf("invalid date %q: %w", s, err)
+	}
+	return DateOf(t), nil
+}
+
+// DateOf returns the date of t in the location of t.
+func DateOf(t time.Time) Date {
+	year, month, day := t.Date()
+	return Date{Year: year, Month: month, Day: day}
+}
+
+// Before reports whether d is before other.
+func (d Date) Before(other Date) bool {
+	if d.Year != other.Year {
+		return d.Year < other.Year
+	}
+	if d.Month != other.Month {
+		return d.Month < other.Month
+	}
+	return d.Day < other.Day
+}
+
+func (d Date) String() string {
+	return time.Date(d.Year, d.Month, d.Day, 0, 0, 0, 0, time.UTC).Format(dateLayout)
+}
+
+// ExceptionDate is a date on which merges are blocked regardless of the schedules.
+type ExceptionDate struct {
+	Date   Date
+	Reason string
+}
+
+// Calendar holds exceptions to the weekly schedules.
+// A calendar is attached to an owner and optionally to a repository.
+type Calendar struct {
+	ExceptionDates []*ExceptionDate
+	// FreezePeriods are periods imported from external calendars.
+	FreezePeriods []*FreezePeriod
+	// UpdateTime is the instant the calendar of the owner was stored last, which is set when it is read from the store.
+	// The calendar of a repository is stored in the config, so it is always zero.
+	UpdateTime time.Time
+}
+
+// FreezePeriod is a period merges are blocked.
+type FreezePeriod struct {
+	Start   time.Time
+	End     time.Time
+	Summary string
+}
+
+// Includes reports whether t is in the period.
+func (p *FreezePeriod) Includes(t time.Time) bool {
+	return !t.Before(p.Start) && t.Before(p.End)
+}
+
+// Blocks reports whether merges are blocked at t by the calendar.
+// Dates are compared in the location of t.
+func (c *Calendar) Blocks(t time.Time) bool {
+	if c == nil {
+		return false
+	}
+	date := DateOf(t)
+	for _, d := range c.ExceptionDates {
+		if d.Date == date {
+			return true
+		}
+	}
+	for _, p := range c.FreezePeriods {
+		if p.Includes(t) {
+			return true
+		}
+	}
+	return false
+}
+
+// ReplaceFreezePeriods replaces the freeze periods with the given periods sorted by their start.
+func (c *Calendar) ReplaceFreezePeriods(periods []*FreezePeriod) {
+	sort.Slice(periods, func(i, j int) bool {
+		return periods[i].Start.Before(periods[j].Start)
+	})
+	c.FreezePeriods = periods
+}
+
+// AddExceptionDate adds the exception date or replaces the reason of the same date.
+func (c *Calendar) AddExceptionDate(exceptionDate *ExceptionDate) {
+	for i, d := range c.ExceptionDates {
+		if d.Date == exceptionDate.Date {
+			c.ExceptionDates[i] = exceptionDate
+			return
+		}
+	}
+	c.ExceptionDates = append(c.ExceptionDates, exceptionDate)
+	sort.Slice(c.ExceptionDates, func(i, j int) bool {
+		return c.ExceptionDates[i].Date.Before(c.ExceptionDates[j].Date)
+	})
+}
+
+// RemoveExceptionDate removes the exception date and reports whether it was found.
+func (c *Calendar) RemoveExceptionDate(date Date) bool {
+	for i, d := range c.ExceptionDates {
+		if d.Date == date {
+			c.ExceptionDates = append(c.ExceptionDates[:i], c.ExceptionDates[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+const (
+	// DefaultStatusContext is the status context used if the config does not have one.
+	DefaultStatusContext = "merge-chance-time"
+	// DefaultOpenDescription is the status description of mergeable pull requests used if the config does not have one.
+	DefaultOpenDescription = "open"
+	// DefaultClosedDescription is the status description of unmergeable pull requests used if the config does not have one.
+	DefaultClosedDescription = "closed"
+
+	maxStatusContextLength     = 100
+	maxStatusDescriptionLength = 100
+)
+
 type RepositoryConfig struct {
-	Owner          string
-	Name           string
+	Owner string
+	Name  string
+	// TimeZone is an IANA time zone name (e.g. "Asia/Tokyo") the schedules are written in.
+	// If it is empty, the schedules are evaluated in the location of the given time.
+	TimeZone       string
 	Schedules      *MergeChanceSchedules
 	MergeAvailable bool
+	// Calendar is the calendar of the repository.
+	Calendar *Calendar
+	// OwnerCalendar is the calendar of the owner of the repository.
+	// It is loaded along with the config but stored apart from it.
+	OwnerCalendar *Calendar
+	// Override forces the merge chance state regardless of the schedules and the calendars until it expires.
+	Override *Override
+	// BypassLabel is the name of the label which makes pull requests mergeable even while merges are unavailable.
+	// Bypassing is disabled if it is empty.
+	BypassLabel string
+	// BaseBranchPatterns are glob patterns of the base branches of gated pull requests (e.g. "release/*").
+	// Pull requests into any branch are gated if it is empty.
+	BaseBranchPatterns []string
+	// BranchRules are evaluated in order, and the first rule matching the base branch of a pull request governs it
+	// instead of Schedules and MergeAvailable of the repository.
+	BranchRules []*BranchRule
+	// StatusContext is the name of the status context or the check run reporting the merge chance on pull requests.
+	// DefaultStatusContext is used if it is empty.
+	StatusContext string
+	// OpenDescription and ClosedDescription describe the statuses of mergeable and unmergeable pull requests.
+	// The defaults are used if they are empty.
+	OpenDescription   string
+	ClosedDescription string
+	// QuarantinedAt is the instant the installation of the owner was found gone.
+	// A quarantined config is left as is until the app is installed again. It is the zero time if the config is not quarantined.
+	QuarantinedAt time.Time
+	// FeedGeneration is embedded in the calendar feed tokens of the repository.
+	// Incrementing it revokes the tokens issued before.
+	FeedGeneration int
+	// UpdateTime is the instant the config was stored last, which is set when the config is read from the store.
+	// It is the zero time if the config has not been read from the store.
+	UpdateTime time.Time
 }
 
-func (c *RepositoryConfig) ShouldStartOn(expected time.Time) bool {
-	if c.MergeAvailable {
+// BranchRule is merge chances of the base branches matching the pattern.
+type BranchRule struct {
+	// Pattern is a glob pattern matched by path.Match (e.g. "release/*").
+	Pattern        string
+	Schedules      *MergeChanceSchedules
+	MergeAvailable bool
+}
+
+// Matches reports whether the rule applies to pull requests into the branch.
+func (r *BranchRule) Matches(branch string) bool {
+	matched, _ := path.Match(r.Pattern, branch)
+	return matched
+}
+
+// Override is an explicit merge chance state set by a user.
+type Override struct {
+	MergeAvailable bool
+	Until          time.Time
+	// CreatedBy is the login of the user who set the override.
+	CreatedBy string
+	CreatedAt time.Time
+}
+
+// ActiveAt reports whether the override is in effect at t.
+func (o *Override) ActiveAt(t time.Time) bool {
+	return o != nil && t.Before(o.Until)
+}
+
+// Quarantined reports whether the config is quarantined because the app is not installed on the owner.
+func (c *RepositoryConfig) Quarantined() bool {
+	return !c.QuarantinedAt.IsZero()
+}
+
+// Location returns the time zone the schedules are evaluated in.
+// It returns nil if TimeZone is empty.
+func (c *RepositoryConfig) Location() (*time.Location, error) {
+	if c.TimeZone == "" {
+		return nil, nil
+	}
+	loc, err := time.LoadLocation(c.TimeZone)
+	if err != nil {
+		return nil, fmt.Errorf("invalid time zone %q: %w", c.TimeZone, err)
+	}
+	return loc, nil
+}
+
+// localTime returns t in the location of the config.
+// t is returned as it is if the time zone is invalid, so the callers check Location beforehand to report the invalid time zone.
+func (c *RepositoryConfig) localTime(t time.Time) time.Time {
+	loc, err := c.Location()
+	if err != nil || loc == nil {
+		return t
+	}
+	return t.In(loc)
+}
+
+// MergeAvailableAt reports whether merges should be available at t according to the override, the schedules and the calendars.
+func (c *RepositoryConfig) MergeAvailableAt(t time.Time) bool {
+	return c.mergeAvailableAt(c.Schedules, t)
+}
+
+// BranchRuleMergeAvailableAt reports whether merges into the branches of the rule should be available at t.
+// The override and the calendars of the repository apply to the rule as well.
+func (c *RepositoryConfig) BranchRuleMergeAvailableAt(rule *BranchRule, t time.Time) bool {
+	return c.mergeAvailableAt(rule.Schedules, t)
+}
+
+func (c *RepositoryConfig) mergeAvailableAt(schedules *MergeChanceSchedules, t time.Time) bool {
+	if c.Override.ActiveAt(t) {
+		return c.Override.MergeAvailable
+	}
+	local := c.localTime(t)
+	if c.Calendar.Blocks(local) || c.OwnerCalendar.Blocks(local) {
 		return false
 	}
-	schedule := c.Schedules.ForWeekday(expected.Weekday())
-	if schedule == nil {
+	for _, schedule := range schedules.ForWeekday(local.Weekday()) {
+		if schedule.Includes(local) {
+			return true
+		}
+	}
+	// the schedules started on the previous day may not have stopped yet
+	yesterday := noonAfter(local, -1)
+	for _, schedule := range schedules.ForWeekday(yesterday.Weekday()) {
+		if schedule.StopsNextDay && schedule.includesStartedOn(yesterday, local) {
+			return true
+		}
+	}
+	return false
+}
+
+// Reconcile sets the merge chance states of the repository and its branch rules to the states desired at t,
+// and reports whether any of them changed.
+func (c *RepositoryConfig) Reconcile(t time.Time) bool {
+	return len(c.ReconcileEvents(t)) > 0
+}
+
+// ReconcileEvents reconciles the states like Reconcile, and returns the audit events of the changed states.
+func (c *RepositoryConfig) ReconcileEvents(t time.Time) []*AuditEvent {
+	events := []*AuditEvent{}
+	if desired := c.MergeAvailableAt(t); c.MergeAvailable != desired {
+		c.MergeAvailable = desired
+		events = append(events, c.transitionEvent("", desired, t))
+	}
+	for _, rule := range c.BranchRules {
+		if desired := c.BranchRuleMergeAvailableAt(rule, t); rule.MergeAvailable != desired {
+			rule.MergeAvailable = desired
+			events = append(events, c.transitionEvent(rule.Pattern, desired, t))
+		}
+	}
+	return events
+}
+
+func (c *RepositoryConfig) transitionEvent(branchPattern string, mergeAvailable bool, t time.Time) *AuditEvent {
+	event := &AuditEvent{Owner: c.Owner, Name: c.Name, Type: AuditEventMergeClosed, BranchPattern: branchPattern, CreatedAt: t}
+	if mergeAvailable {
+		event.Type = AuditEventMergeOpened
+	}
+	return event
+}
+
+// transitionLookaheadDays is how far NextTransition looks for a change of the merge chance state.
+const transitionLookaheadDays = 366
+
+// NextTransition returns the first instant after t at which merges into baseBranch become available or unavailable
+// according to the override, the calendars and the schedules governing the branch.
+// The instant is in the location of the schedules. It reports false if the state does not change within a year.
+func (c *RepositoryConfig) NextTransition(baseBranch string, t time.Time) (time.Time, bool) {
+	schedules := c.Schedules
+	if rule := c.BranchRuleFor(baseBranch); rule != nil {
+		schedules = rule.Schedules
+	}
+	current := c.mergeAvailableAt(schedules, t)
+	for _, at := range c.transitionCandidates(schedules, t) {
+		if c.mergeAvailableAt(schedules, at) != current {
+			return c.localTime(at), true
+		}
+	}
+	return time.Time{}, false
+}
+
+// transitionCandidates returns the instants after t the merge chance state may change at, in chronological order.
+func (c *RepositoryConfig) transitionCandidates(schedules *MergeChanceSchedules, t time.Time) []time.Time {
+	candidates := []time.Time{}
+	if c.Override != nil {
+		candidates = append(candidates, c.Override.Until)
+	}
+	for _, calendar := range []*Calendar{c.Calendar, c.OwnerCalendar} {
+		if calendar == nil {
+			continue
+		}
+		for _, p := range calendar.FreezePeriods {
+			candidates = append(candidates, p.Start, p.End)
+		}
+	}
+	local := c.localTime(t)
+	// starts from the previous day because the schedules started on it may stop on the day of t
+	for i := -1; i <= transitionLookaheadDays; i++ {
+		day := noonAfter(local, i)
+		candidates = append(candidates, wallClock(day, 0, 0))
+		for _, schedule := range schedules.ForWeekday(day.Weekday()) {
+			candidates = append(candidates, schedule.StartOn(day), schedule.StopOn(day))
+		}
+	}
+
+	after := candidates[:0]
+	for _, at := range candidates {
+		if at.After(t) {
+			after = append(after, at)
+		}
+	}
+	sort.Slice(after, func(i, j int) bool {
+		return after[i].Before(after[j])
+	})
+	return after
+}
+
+// BranchRuleFor returns the first branch rule matching the branch, or nil if no rule matches.
+func (c *RepositoryConfig) BranchRuleFor(branch string) *BranchRule {
+	for _, rule := range c.BranchRules {
+		if rule.Matches(branch) {
+			return rule
+		}
+	}
+	return nil
+}
+
+// Gates reports whether pull requests into the branch are gated by either a branch rule or the repository.
+func (c *RepositoryConfig) Gates(branch string) bool {
+	return c.BranchRuleFor(branch) != nil || c.GatesBaseBranch(branch)
+}
+
+// PullRequestMergeable reports whether a pull request into baseBranch labeled with labelNames is mergeable in the current state.
+// The first branch rule matching baseBranch takes precedence over the state of the repository.
+func (c *RepositoryConfig) PullRequestMergeable(baseBranch string, labelNames []string) bool {
+	if c.BypassedBy(labelNames) {
+		return true
+	}
+	if rule := c.BranchRuleFor(baseBranch); rule != nil {
+		return rule.MergeAvailable
+	}
+	if !c.GatesBaseBranch(baseBranch) {
+		return true
+	}
+	return c.MergeAvailable
+}
+
+// BypassedBy reports whether the pull request labeled with labelNames is mergeable regardless of the merge chance state.
+func (c *RepositoryConfig) BypassedBy(labelNames []string) bool {
+	if c.BypassLabel == "" {
 		return false
 	}
-	return expected.Hour() == schedule.StartHour
+	for _, name := range labelNames {
+		if name == c.BypassLabel {
+			return true
+		}
+	}
+	return false
+}
+
+// GatesBaseBranch reports whether pull requests into the branch are gated by the merge chance state.
+// Patterns are matched by path.Match, so "*" does not match "/".
+func (c *RepositoryConfig) GatesBaseBranch(branch string) bool {
+	if len(c.BaseBranchPatterns) == 0 {
+		return true
+	}
+	for _, pattern := range c.BaseBranchPatterns {
+		if matched, _ := path.Match(pattern, branch); matched {
+			return true
+		}
+	}
+	return false
 }
 
+// StatusContextName returns the name of the status context reporting the merge chance.
+func (c *RepositoryConfig) StatusContextName() string {
+	if c.StatusContext == "" {
+		return DefaultStatusContext
+	}
+	return c.StatusContext
+}
+
+// StatusDescription returns the description of the status of pull requests mergeable or not.
+func (c *RepositoryConfig) StatusDescription(mergeable bool) string {
+	if mergeable {
+		if c.OpenDescription == "" {
+			return DefaultOpenDescription
+		}
+		return c.OpenDescription
+	}
+	if c.ClosedDescription == "" {
+		return DefaultClosedDescription
+	}
+	return c.ClosedDescription
+}
+
+// ShouldStartOn reports whether merges are unavailable although they should be available at expected.
+func (c *RepositoryConfig) ShouldStartOn(expected time.Time) bool {
+	return !c.MergeAvailable && c.MergeAvailableAt(expected)
+}
+
+// ShouldStopOn reports whether merges are available although they should be unavailable at expected.
 func (c *RepositoryConfig) ShouldStopOn(expected time.Time) bool {
-	if !c.MergeAvailable {
-		return false
+	return c.MergeAvailable && !c.MergeAvailableAt(expected)
+}
+
+// wallClock returns the instant the wall clock shows hour:min on the date of t in the location of t.
+// If the wall clock skips that time due to a DST transition, the time is shifted forward by the length of the gap
+// (e.g. 02:30 is 03:30 when the clock jumps from 02:00 to 03:00).
+func wallClock(t time.Time, hour, min int) time.Time {
+	year, month, day := t.Date()
+	at := time.Date(year, month, day, hour, min, 0, 0, t.Location())
+	if at.Hour() != hour || at.Minute() != min {
+		_, offset := at.Zone()
+		_, offsetAfter := at.Add(24 * time.Hour).Zone()
+		at = at.Add(time.Duration(offsetAfter-offset) * time.Second)
 	}
-	schedule := c.Schedules.ForWeekday(expected.Weekday())
-	if schedule == nil {
-		return false
+	return at
+}
+
+// FieldError is a violation of the constraints on a field of the config.
+type FieldError struct {
+	// Field is the path to the field from the config (e.g. "schedules.monday[0].stopHour").
+	Field   string
+	Message string
+}
+
+func (e *FieldError) Error() string {
+	return fmt.Sprintf("%s %s", e.Field, e.Message)
+}
+
+// ValidationError holds all the violations found in the config.
+type ValidationError struct {
+	Errors []*FieldError
+}
+
+func (e *ValidationError) Error() string {
+	msgs := make([]string, len(e.Errors))
+	for i, fe := range e.Errors {
+		msgs[i] = fe.Error()
 	}
-	return expected.Hour() == schedule.StopHour
+	return fmt.Sprintf("invalid config: %s", strings.Join(msgs, "; "))
 }
 
+type fieldErrors []*FieldError
+
+func (errs *fieldErrors) add(field, format string, args ...interface{}) {
+	*errs = append(*errs, &FieldError{Field: field, Message: fmt.Sprintf(format, args...)})
+}
+
+// Valid returns *ValidationError holding all the violations of the config, or nil if there is none.
 func (c *RepositoryConfig) Valid() error {
+	errs := fieldErrors{}
 	if c.Owner == "" {
-		return fmt.Errorf("Owner must not be empty")
+		errs.add("owner", "must not be empty")
 	}
 	if c.Name == "" {
-		return fmt.Errorf("Name must not be empty")
+		errs.add("name", "must not be empty")
+	}
+	if _, err := c.Location(); err != nil {
+		errs.add("timeZone", "must be an IANA time zone name: %s", err)
+	}
+	if len(c.StatusContext) > maxStatusContextLength {
+		errs.add("statusContext", "must not be longer than %d characters", maxStatusContextLength)
+	}
+	if len(c.OpenDescription) > maxStatusDescriptionLength {
+		errs.add("openDescription", "must not be longer than %d characters", maxStatusDescriptionLength)
+	}
+	if len(c.ClosedDescription) > maxStatusDescriptionLength {
+		errs.add("closedDescription", "must not be longer than %d characters", maxStatusDescriptionLength)
+	}
+	for i, pattern := range c.BaseBranchPatterns {
+		if _, err := path.Match(pattern, ""); err != nil {
+			errs.add(fmt.Sprintf("baseBranchPatterns[%d]", i), "is an invalid pattern %q: %s", pattern, err)
+		}
+	}
+	if c.Schedules != nil {
+		c.Schedules.validate("schedules", &errs)
+	}
+	for i, rule := range c.BranchRules {
+		field := fmt.Sprintf("branchRules[%d]", i)
+		if rule.Pattern == "" {
+			errs.add(field+".pattern", "must not be empty")
+		} else if _, err := path.Match(rule.Pattern, ""); err != nil {
+			errs.add(field+".pattern", "is an invalid pattern %q: %s", rule.Pattern, err)
+		}
+		if rule.Schedules != nil {
+			rule.Schedules.validate(field+".schedules", &errs)
+		}
+	}
+	if len(errs) > 0 {
+		return &ValidationError{Errors: errs}
 	}
 	return nil
 }
+
+type AuditEventType string
+
+const (
+	// AuditEventConfigUpdated is recorded when a user updates the config of the repository.
+	AuditEventConfigUpdated AuditEventType = "CONFIG_UPDATED"
+	// AuditEventMergeOpened and AuditEventMergeClosed are recorded when the merge chance of the repository or its branch rule changes.
+	AuditEventMergeOpened AuditEventType = "MERGE_OPENED"
+	AuditEventMergeClosed AuditEventType = "MERGE_CLOSED"
+	// AuditEventOverrideSet is recorded when a user forces merges open or closed.
+	AuditEventOverrideSet AuditEventType = "OVERRIDE_SET"
+	// AuditEventOverrideExpired is recorded when the override is cleared after it expires.
+	AuditEventOverrideExpired AuditEventType = "OVERRIDE_EXPIRED"
+	// AuditEventOverrideRequested is recorded when a user requests an override on a pull request.
+	AuditEventOverrideRequested AuditEventType = "OVERRIDE_REQUESTED"
+)
+
+// AuditEvent is a change of the config or the merge chance of the repository.
+type AuditEvent struct {
+	// ID is assigned by the repository on store.
+	ID    string
+	Owner string
+	Name  string
+	Type  AuditEventType
+	// Actor is the login of the user who made the change. It is empty if the change is made automatically.
+	Actor string
+	// BranchPattern is the pattern of the branch rule whose merge chance changed. It is empty for the repository itself.
+	BranchPattern string
+	// Description describes the change in detail (e.g. the changed fields of the config).
+	Description string
+	CreatedAt   time.Time
+}
+
+// ConfigUpdatedEvent returns the audit event of the user updating the config from before to c.
+// before is nil if the config is created.
+func (c *RepositoryConfig) ConfigUpdatedEvent(before *RepositoryConfig, actor string, t time.Time) *AuditEvent {
+	description := "created"
+	if before != nil {
+		description = "changed " + strings.Join(before.changedFields(c), ", ")
+	}
+	return &AuditEvent{Owner: c.Owner, Name: c.Name, Type: AuditEventConfigUpdated, Actor: actor, Description: description, CreatedAt: t}
+}
+
+// changedFields returns the names of the fields of the config edited by users which differ from other.
+func (c *RepositoryConfig) changedFields(other *RepositoryConfig) []string {
+	fields := []string{}
+	if !reflect.DeepEqual(c.Schedules, other.Schedules) {
+		fields = append(fields, "schedules")
+	}
+	if c.TimeZone != other.TimeZone {
+		fields = append(fields, "timeZone")
+	}
+	if c.BypassLabel != other.BypassLabel {
+		fields = append(fields, "bypassLabel")
+	}
+	if !reflect.DeepEqual(c.BaseBranchPatterns, other.BaseBranchPatterns) {
+		fields = append(fields, "baseBranchPatterns")
+	}
+	if !sameBranchRules(c.BranchRules, other.BranchRules) {
+		fields = append(fields, "branchRules")
+	}
+	if c.StatusContext != other.StatusContext {
+		fields = append(fields, "statusContext")
+	}
+	if c.OpenDescription != other.OpenDescription || c.ClosedDescription != other.ClosedDescription {
+		fields = append(fields, "descriptions")
+	}
+	if len(fields) == 0 {
+		fields = append(fields, "nothing")
+	}
+	return fields
+}
+
+// sameBranchRules reports whether the rules have the same patterns and schedules regardless of their states.
+func sameBranchRules(a, b []*BranchRule) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].Pattern != b[i].Pattern || !reflect.DeepEqual(a[i].Schedules, b[i].Schedules) {
+			return false
+		}
+	}
+	return true
+}
+
+// OverrideSetEvent returns the audit event of the user setting the override on the repository.
+func (c *RepositoryConfig) OverrideSetEvent(o *Override) *AuditEvent {
+	return &AuditEvent{
+		Owner:       c.Owner,
+		Name:        c.Name,
+		Type:        AuditEventOverrideSet,
+		Actor:       o.CreatedBy,
+		Description: fmt.Sprintf("forced %s until %s", o.state(), o.Until.Format(time.RFC3339)),
+		CreatedAt:   o.CreatedAt,
+	}
+}
+
+// OverrideExpiredEvent returns the audit event of the override of the config expiring at t.
+// The expiry is not made by the user who set the override, so the user is described instead of being the actor.
+func (c *RepositoryConfig) OverrideExpiredEvent(t time.Time) *AuditEvent {
+	o := c.Override
+	return &AuditEvent{
+		Owner:       c.Owner,
+		Name:        c.Name,
+		Type:        AuditEventOverrideExpired,
+		Description: fmt.Sprintf("forced %s by %s until %s", o.state(), o.CreatedBy, o.Until.Format(time.RFC3339)),
+		CreatedAt:   t,
+	}
+}
+
+func (o *Override) state() string {
+	if o.MergeAvailable {
+		return "open"
+	}
+	return "closed"
+}
+
+// Permission is the permission level of a user on a repository in ascending order.
+type Permission int
+
+const (
+	PermissionNone Permission = iota
+	PermissionRead
+	PermissionTriage
+	PermissionWrite
+	PermissionMaintain
+	PermissionAdmin
+)
+
+var permissionNames = map[Permission]string{
+	PermissionNone:     "none",
+	PermissionRead:     "read",
+	PermissionTriage:   "triage",
+	PermissionWrite:    "write",
+	PermissionMaintain: "maintain",
+	PermissionAdmin:    "admin",
+}
+
+func (p Permission) String() string {
+	return permissionNames[p]
+}
+
+// ParsePermission parses the name of the permission. The legacy names "pull" and "push" are accepted as read and write.
+func ParsePermission(s string) (Permission, error) {
+	switch s {
+	case "pull":
+		return PermissionRead, nil
+	case "push":
+		return PermissionWrite, nil
+	}
+	for p, name := range permissionNames {
+		if p != PermissionNone && name == s {
+			return p, nil
+		}
+	}
+	return PermissionNone, fmt.Errorf("unknown permission %q", s)
+}
+
+// PermissionOf returns the highest permission of the permissions of a repository reported by GitHub (e.g. {"admin": false, "push": true, "pull": true}).
+func PermissionOf(permissions map[string]bool) Permission {
+	switch {
+	case permissions["admin"]:
+		return PermissionAdmin
+	case permissions["maintain"]:
+		return PermissionMaintain
+	case permissions["push"]:
+		return PermissionWrite
+	case permissions["triage"]:
+		return PermissionTriage
+	case permissions["pull"]:
+		return PermissionRead
+	default:
+		return PermissionNone
+	}
+}
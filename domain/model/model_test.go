@@ -1,6 +1,9 @@
 package model
 
 import (
+	"errors"
+	"reflect"
+	"strings"
 	"testing"
 	"time"
 )
@@ -28,34 +31,34 @@ func TestRepositoryConfig_ShouldStartOn(t *testing.T) {
 			cfg: &RepositoryConfig{
 				MergeAvailable: false,
 				Schedules: &MergeChanceSchedules{
-					Sunday: &MergeChanceSchedule{
+					Sunday: []*MergeChanceSchedule{{
 						StartHour: 0,
 						StopHour:  23,
-					},
-					Monday: &MergeChanceSchedule{
+					}},
+					Monday: []*MergeChanceSchedule{{
 						StartHour: 0,
 						StopHour:  23,
-					},
-					Tuesday: &MergeChanceSchedule{
+					}},
+					Tuesday: []*MergeChanceSchedule{{
 						StartHour: 0,
 						StopHour:  23,
-					},
-					Wednesday: &MergeChanceSchedule{
+					}},
+					Wednesday: []*MergeChanceSchedule{{
 						StartHour: 0,
 						StopHour:  23,
-					},
-					Thursday: &MergeChanceSchedule{
+					}},
+					Thursday: []*MergeChanceSchedule{{
 						StartHour: 0,
 						StopHour:  23,
-					},
-					Friday: &MergeChanceSchedule{
+					}},
+					Friday: []*MergeChanceSchedule{{
 						StartHour: 0,
 						StopHour:  23,
-					},
-					Saturday: &MergeChanceSchedule{
+					}},
+					Saturday: []*MergeChanceSchedule{{
 						StartHour: 0,
 						StopHour:  23,
-					},
+					}},
 				},
 			},
 			args: args{
@@ -68,34 +71,34 @@ func TestRepositoryConfig_ShouldStartOn(t *testing.T) {
 			cfg: &RepositoryConfig{
 				MergeAvailable: true,
 				Schedules: &MergeChanceSchedules{
-					Sunday: &MergeChanceSchedule{
+					Sunday: []*MergeChanceSchedule{{
 						StartHour: 0,
 						StopHour:  23,
-					},
-					Monday: &MergeChanceSchedule{
+					}},
+					Monday: []*MergeChanceSchedule{{
 						StartHour: 0,
 						StopHour:  23,
-					},
-					Tuesday: &MergeChanceSchedule{
+					}},
+					Tuesday: []*MergeChanceSchedule{{
 						StartHour: 0,
 						StopHour:  23,
-					},
-					Wednesday: &MergeChanceSchedule{
+					}},
+					Wednesday: []*MergeChanceSchedule{{
 						StartHour: 0,
 						StopHour:  23,
-					},
-					Thursday: &MergeChanceSchedule{
+					}},
+					Thursday: []*MergeChanceSchedule{{
 						StartHour: 0,
 						StopHour:  23,
-					},
-					Friday: &MergeChanceSchedule{
+					}},
+					Friday: []*MergeChanceSchedule{{
 						StartHour: 0,
 						StopHour:  23,
-					},
-					Saturday: &MergeChanceSchedule{
+					}},
+					Saturday: []*MergeChanceSchedule{{
 						StartHour: 0,
 						StopHour:  23,
-					},
+					}},
 				},
 			},
 			args: args{
@@ -103,6 +106,225 @@ func TestRepositoryConfig_ShouldStartOn(t *testing.T) {
 			},
 			want: false,
 		},
+		{
+			name: "in time zone",
+			cfg: &RepositoryConfig{
+				MergeAvailable: false,
+				TimeZone:       "Asia/Tokyo",
+				Schedules: &MergeChanceSchedules{
+					Monday: []*MergeChanceSchedule{{
+						StartHour: 10,
+						StopHour:  18,
+					}},
+				},
+			},
+			args: args{
+				expected: mustParseTime("2020-02-03T01:00:00Z"),
+			},
+			want: true,
+		},
+		{
+			name: "in time zone / not yet",
+			cfg: &RepositoryConfig{
+				MergeAvailable: false,
+				TimeZone:       "Asia/Tokyo",
+				Schedules: &MergeChanceSchedules{
+					Monday: []*MergeChanceSchedule{{
+						StartHour: 10,
+						StopHour:  18,
+					}},
+				},
+			},
+			args: args{
+				expected: mustParseTime("2020-02-03T10:00:00Z"),
+			},
+			want: false,
+		},
+		{
+			name: "in time zone / weekday differs from UTC",
+			cfg: &RepositoryConfig{
+				MergeAvailable: false,
+				TimeZone:       "Asia/Tokyo",
+				Schedules: &MergeChanceSchedules{
+					Monday: []*MergeChanceSchedule{{
+						StartHour: 0,
+						StopHour:  23,
+					}},
+				},
+			},
+			args: args{
+				expected: mustParseTime("2020-02-02T15:00:00Z"),
+			},
+			want: true,
+		},
+		{
+			name: "minute precision",
+			cfg: &RepositoryConfig{
+				MergeAvailable: false,
+				Schedules: &MergeChanceSchedules{
+					Monday: []*MergeChanceSchedule{{
+						StartHour:   9,
+						StartMinute: 30,
+						StopHour:    18,
+					}},
+				},
+			},
+			args: args{
+				expected: mustParseTime("2020-02-03T09:35:00Z"),
+			},
+			want: true,
+		},
+		{
+			name: "minute precision / not yet",
+			cfg: &RepositoryConfig{
+				MergeAvailable: false,
+				Schedules: &MergeChanceSchedules{
+					Monday: []*MergeChanceSchedule{{
+						StartHour:   9,
+						StartMinute: 30,
+						StopHour:    18,
+					}},
+				},
+			},
+			args: args{
+				expected: mustParseTime("2020-02-03T09:25:00Z"),
+			},
+			want: false,
+		},
+		{
+			name: "minute precision / already stopped",
+			cfg: &RepositoryConfig{
+				MergeAvailable: false,
+				Schedules: &MergeChanceSchedules{
+					Monday: []*MergeChanceSchedule{{
+						StartHour:   9,
+						StartMinute: 30,
+						StopHour:    18,
+					}},
+				},
+			},
+			args: args{
+				expected: mustParseTime("2020-02-03T18:00:00Z"),
+			},
+			want: false,
+		},
+		{
+			name: "start hour skipped by DST",
+			cfg: &RepositoryConfig{
+				MergeAvailable: false,
+				TimeZone:       "America/Los_Angeles",
+				Schedules: &MergeChanceSchedules{
+					Sunday: []*MergeChanceSchedule{{
+						StartHour: 2,
+						StopHour:  18,
+					}},
+				},
+			},
+			args: args{
+				expected: mustParseTime("2020-03-08T10:00:00Z"),
+			},
+			want: true,
+		},
+		{
+			name: "start hour skipped by DST / before the gap",
+			cfg: &RepositoryConfig{
+				MergeAvailable: false,
+				TimeZone:       "America/Los_Angeles",
+				Schedules: &MergeChanceSchedules{
+					Sunday: []*MergeChanceSchedule{{
+						StartHour: 2,
+						StopHour:  18,
+					}},
+				},
+			},
+			args: args{
+				expected: mustParseTime("2020-03-08T09:00:00Z"),
+			},
+			want: false,
+		},
+		{
+			name: "stop hour repeated by DST / second occurrence",
+			cfg: &RepositoryConfig{
+				MergeAvailable: false,
+				TimeZone:       "America/Los_Angeles",
+				Schedules: &MergeChanceSchedules{
+					Sunday: []*MergeChanceSchedule{{
+						StartHour: 0,
+						StopHour:  1,
+					}},
+				},
+			},
+			args: args{
+				expected: mustParseTime("2020-11-01T09:00:00Z"),
+			},
+			want: false,
+		},
+		{
+			name: "multiple windows / after lunch",
+			cfg: &RepositoryConfig{
+				MergeAvailable: false,
+				Schedules: &MergeChanceSchedules{
+					Monday: []*MergeChanceSchedule{
+						{StartHour: 10, StopHour: 12},
+						{StartHour: 13, StopHour: 18},
+					},
+				},
+			},
+			args: args{
+				expected: mustParseTime("2020-02-03T13:00:00Z"),
+			},
+			want: true,
+		},
+		{
+			name: "multiple windows / lunch",
+			cfg: &RepositoryConfig{
+				MergeAvailable: false,
+				Schedules: &MergeChanceSchedules{
+					Monday: []*MergeChanceSchedule{
+						{StartHour: 10, StopHour: 12},
+						{StartHour: 13, StopHour: 18},
+					},
+				},
+			},
+			args: args{
+				expected: mustParseTime("2020-02-03T12:30:00Z"),
+			},
+			want: false,
+		},
+		{
+			name: "overnight from Saturday to Sunday",
+			cfg: &RepositoryConfig{
+				MergeAvailable: false,
+				Schedules: &MergeChanceSchedules{
+					Saturday: []*MergeChanceSchedule{{
+						StartHour:    22,
+						StopHour:     2,
+						StopsNextDay: true,
+					}},
+				},
+			},
+			args: args{
+				expected: mustParseTime("2020-02-02T01:59:00Z"),
+			},
+			want: true,
+		},
+		{
+			name: "overnight from Friday does not continue to Sunday",
+			cfg: &RepositoryConfig{
+				MergeAvailable: false,
+				Schedules: &MergeChanceSchedules{
+					Friday: []*MergeChanceSchedule{{
+						StartHour:    22,
+						StopHour:     2,
+						StopsNextDay: true,
+					}},
+				},
+			},
+			args: args{
+				expected: mustParseTime("2020-02-02T01:00:00Z"),
+			},
+			want: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -128,34 +350,34 @@ func TestRepositoryConfig_ShouldStopOn(t *testing.T) {
 			cfg: &RepositoryConfig{
 				MergeAvailable: true,
 				Schedules: &MergeChanceSchedules{
-					Sunday: &MergeChanceSchedule{
+					Sunday: []*MergeChanceSchedule{{
 						StartHour: 0,
 						StopHour:  23,
-					},
-					Monday: &MergeChanceSchedule{
+					}},
+					Monday: []*MergeChanceSchedule{{
 						StartHour: 0,
 						StopHour:  23,
-					},
-					Tuesday: &MergeChanceSchedule{
+					}},
+					Tuesday: []*MergeChanceSchedule{{
 						StartHour: 0,
 						StopHour:  23,
-					},
-					Wednesday: &MergeChanceSchedule{
+					}},
+					Wednesday: []*MergeChanceSchedule{{
 						StartHour: 0,
 						StopHour:  23,
-					},
-					Thursday: &MergeChanceSchedule{
+					}},
+					Thursday: []*MergeChanceSchedule{{
 						StartHour: 0,
 						StopHour:  23,
-					},
-					Friday: &MergeChanceSchedule{
+					}},
+					Friday: []*MergeChanceSchedule{{
 						StartHour: 0,
 						StopHour:  23,
-					},
-					Saturday: &MergeChanceSchedule{
+					}},
+					Saturday: []*MergeChanceSchedule{{
 						StartHour: 0,
 						StopHour:  23,
-					},
+					}},
 				},
 			},
 			args: args{
@@ -168,34 +390,34 @@ func TestRepositoryConfig_ShouldStopOn(t *testing.T) {
 			cfg: &RepositoryConfig{
 				MergeAvailable: false,
 				Schedules: &MergeChanceSchedules{
-					Sunday: &MergeChanceSchedule{
+					Sunday: []*MergeChanceSchedule{{
 						StartHour: 0,
 						StopHour:  23,
-					},
-					Monday: &MergeChanceSchedule{
+					}},
+					Monday: []*MergeChanceSchedule{{
 						StartHour: 0,
 						StopHour:  23,
-					},
-					Tuesday: &MergeChanceSchedule{
+					}},
+					Tuesday: []*MergeChanceSchedule{{
 						StartHour: 0,
 						StopHour:  23,
-					},
-					Wednesday: &MergeChanceSchedule{
+					}},
+					Wednesday: []*MergeChanceSchedule{{
 						StartHour: 0,
 						StopHour:  23,
-					},
-					Thursday: &MergeChanceSchedule{
+					}},
+					Thursday: []*MergeChanceSchedule{{
 						StartHour: 0,
 						StopHour:  23,
-					},
-					Friday: &MergeChanceSchedule{
+					}},
+					Friday: []*MergeChanceSchedule{{
 						StartHour: 0,
 						StopHour:  23,
-					},
-					Saturday: &MergeChanceSchedule{
+					}},
+					Saturday: []*MergeChanceSchedule{{
 						StartHour: 0,
 						StopHour:  23,
-					},
+					}},
 				},
 			},
 			args: args{
@@ -203,12 +425,1005 @@ func TestRepositoryConfig_ShouldStopOn(t *testing.T) {
 			},
 			want: false,
 		},
-	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			if got := tt.cfg.ShouldStopOn(tt.args.expected); got != tt.want {
-				t.Errorf("RepositoryConfig.ShouldStopOn() = %v, want %v", got, tt.want)
-			}
-		})
+		{
+			name: "stop hour repeated by DST",
+			cfg: &RepositoryConfig{
+				MergeAvailable: true,
+				TimeZone:       "America/Los_Angeles",
+				Schedules: &MergeChanceSchedules{
+					Sunday: []*MergeChanceSchedule{{
+						StartHour: 0,
+						StopHour:  1,
+					}},
+				},
+			},
+			args: args{
+				expected: mustParseTime("2020-11-01T08:00:00Z"),
+			},
+			want: true,
+		},
+		{
+			// merges were stopped on the first occurrence, and the repeated hour does not stop them again
+			name: "stop hour repeated by DST / second occurrence",
+			cfg: &RepositoryConfig{
+				MergeAvailable: false,
+				TimeZone:       "America/Los_Angeles",
+				Schedules: &MergeChanceSchedules{
+					Sunday: []*MergeChanceSchedule{{
+						StartHour: 0,
+						StopHour:  1,
+					}},
+				},
+			},
+			args: args{
+				expected: mustParseTime("2020-11-01T09:00:00Z"),
+			},
+			want: false,
+		},
+		{
+			name: "stop time repeated by DST / second occurrence",
+			cfg: &RepositoryConfig{
+				MergeAvailable: true,
+				TimeZone:       "America/Los_Angeles",
+				Schedules: &MergeChanceSchedules{
+					Sunday: []*MergeChanceSchedule{{
+						StartHour:  0,
+						StopHour:   1,
+						StopMinute: 30,
+					}},
+				},
+			},
+			args: args{
+				expected: mustParseTime("2020-11-01T09:00:00Z"),
+			},
+			want: true,
+		},
+		{
+			name: "minute precision",
+			cfg: &RepositoryConfig{
+				MergeAvailable: true,
+				Schedules: &MergeChanceSchedules{
+					Monday: []*MergeChanceSchedule{{
+						StartHour:  9,
+						StopHour:   17,
+						StopMinute: 45,
+					}},
+				},
+			},
+			args: args{
+				expected: mustParseTime("2020-02-03T17:50:00Z"),
+			},
+			want: true,
+		},
+		{
+			name: "minute precision / before stop",
+			cfg: &RepositoryConfig{
+				MergeAvailable: true,
+				Schedules: &MergeChanceSchedules{
+					Monday: []*MergeChanceSchedule{{
+						StartHour:  9,
+						StopHour:   17,
+						StopMinute: 45,
+					}},
+				},
+			},
+			args: args{
+				expected: mustParseTime("2020-02-03T17:40:00Z"),
+			},
+			want: false,
+		},
+		{
+			name: "multiple windows / lunch",
+			cfg: &RepositoryConfig{
+				MergeAvailable: true,
+				Schedules: &MergeChanceSchedules{
+					Monday: []*MergeChanceSchedule{
+						{StartHour: 10, StopHour: 12},
+						{StartHour: 13, StopHour: 18},
+					},
+				},
+			},
+			args: args{
+				expected: mustParseTime("2020-02-03T12:00:00Z"),
+			},
+			want: true,
+		},
+		{
+			name: "multiple windows / in the second window",
+			cfg: &RepositoryConfig{
+				MergeAvailable: true,
+				Schedules: &MergeChanceSchedules{
+					Monday: []*MergeChanceSchedule{
+						{StartHour: 10, StopHour: 12},
+						{StartHour: 13, StopHour: 18},
+					},
+				},
+			},
+			args: args{
+				expected: mustParseTime("2020-02-03T15:00:00Z"),
+			},
+			want: false,
+		},
+		{
+			name: "multiple windows / before the first window",
+			cfg: &RepositoryConfig{
+				MergeAvailable: true,
+				Schedules: &MergeChanceSchedules{
+					Monday: []*MergeChanceSchedule{
+						{StartHour: 10, StopHour: 12},
+						{StartHour: 13, StopHour: 18},
+					},
+				},
+			},
+			args: args{
+				expected: mustParseTime("2020-02-03T09:00:00Z"),
+			},
+			want: true,
+		},
+		{
+			name: "no windows on the day",
+			cfg: &RepositoryConfig{
+				MergeAvailable: true,
+				Schedules: &MergeChanceSchedules{
+					Monday: []*MergeChanceSchedule{{StartHour: 0, StopHour: 23}},
+				},
+			},
+			args: args{
+				expected: mustParseTime("2020-02-02T12:00:00Z"),
+			},
+			want: true,
+		},
+		{
+			name: "exception date of the repository",
+			cfg: &RepositoryConfig{
+				MergeAvailable: true,
+				Schedules: &MergeChanceSchedules{
+					Monday: []*MergeChanceSchedule{{StartHour: 0, StopHour: 23}},
+				},
+				Calendar: &Calendar{
+					ExceptionDates: []*ExceptionDate{{Date: Date{Year: 2020, Month: time.February, Day: 3}}},
+				},
+			},
+			args: args{
+				expected: mustParseTime("2020-02-03T12:00:00Z"),
+			},
+			want: true,
+		},
+		{
+			name: "exception date of the owner",
+			cfg: &RepositoryConfig{
+				MergeAvailable: true,
+				Schedules: &MergeChanceSchedules{
+					Monday: []*MergeChanceSchedule{{StartHour: 0, StopHour: 23}},
+				},
+				OwnerCalendar: &Calendar{
+					ExceptionDates: []*ExceptionDate{{Date: Date{Year: 2020, Month: time.February, Day: 3}}},
+				},
+			},
+			args: args{
+				expected: mustParseTime("2020-02-03T12:00:00Z"),
+			},
+			want: true,
+		},
+		{
+			name: "exception date in time zone",
+			cfg: &RepositoryConfig{
+				MergeAvailable: true,
+				TimeZone:       "Asia/Tokyo",
+				Schedules: &MergeChanceSchedules{
+					Monday: []*MergeChanceSchedule{{StartHour: 0, StopHour: 23}},
+				},
+				OwnerCalendar: &Calendar{
+					ExceptionDates: []*ExceptionDate{{Date: Date{Year: 2020, Month: time.February, Day: 2}}},
+				},
+			},
+			args: args{
+				expected: mustParseTime("2020-02-02T16:00:00Z"),
+			},
+			want: false,
+		},
+		{
+			name: "in freeze period",
+			cfg: &RepositoryConfig{
+				MergeAvailable: true,
+				Schedules: &MergeChanceSchedules{
+					Monday: []*MergeChanceSchedule{{StartHour: 0, StopHour: 23}},
+				},
+				OwnerCalendar: &Calendar{
+					FreezePeriods: []*FreezePeriod{{Start: mustParseTime("2020-02-03T10:00:00Z"), End: mustParseTime("2020-02-03T13:00:00Z")}},
+				},
+			},
+			args: args{
+				expected: mustParseTime("2020-02-03T12:00:00Z"),
+			},
+			want: true,
+		},
+		{
+			name: "after freeze period",
+			cfg: &RepositoryConfig{
+				MergeAvailable: true,
+				Schedules: &MergeChanceSchedules{
+					Monday: []*MergeChanceSchedule{{StartHour: 0, StopHour: 23}},
+				},
+				Calendar: &Calendar{
+					FreezePeriods: []*FreezePeriod{{Start: mustParseTime("2020-02-03T10:00:00Z"), End: mustParseTime("2020-02-03T12:00:00Z")}},
+				},
+			},
+			args: args{
+				expected: mustParseTime("2020-02-03T12:00:00Z"),
+			},
+			want: false,
+		},
+		{
+			name: "opened by override",
+			cfg: &RepositoryConfig{
+				MergeAvailable: true,
+				Schedules: &MergeChanceSchedules{
+					Monday: []*MergeChanceSchedule{{StartHour: 0, StopHour: 23}},
+				},
+				Calendar: &Calendar{
+					ExceptionDates: []*ExceptionDate{{Date: Date{Year: 2020, Month: time.February, Day: 3}}},
+				},
+				Override: &Override{MergeAvailable: true, Until: mustParseTime("2020-02-03T13:00:00Z")},
+			},
+			args: args{
+				expected: mustParseTime("2020-02-03T12:00:00Z"),
+			},
+			want: false,
+		},
+		{
+			name: "override expired",
+			cfg: &RepositoryConfig{
+				MergeAvailable: true,
+				Schedules: &MergeChanceSchedules{
+					Monday: []*MergeChanceSchedule{{StartHour: 0, StopHour: 23}},
+				},
+				Calendar: &Calendar{
+					ExceptionDates: []*ExceptionDate{{Date: Date{Year: 2020, Month: time.February, Day: 3}}},
+				},
+				Override: &Override{MergeAvailable: true, Until: mustParseTime("2020-02-03T12:00:00Z")},
+			},
+			args: args{
+				expected: mustParseTime("2020-02-03T12:00:00Z"),
+			},
+			want: true,
+		},
+		{
+			name: "whole day / last hour",
+			cfg: &RepositoryConfig{
+				MergeAvailable: true,
+				Schedules: &MergeChanceSchedules{
+					Monday: []*MergeChanceSchedule{WholeDay},
+				},
+			},
+			args: args{
+				expected: mustParseTime("2020-02-03T23:30:00Z"),
+			},
+			want: false,
+		},
+		{
+			name: "whole day / next day",
+			cfg: &RepositoryConfig{
+				MergeAvailable: true,
+				Schedules: &MergeChanceSchedules{
+					Monday: []*MergeChanceSchedule{WholeDay},
+				},
+			},
+			args: args{
+				expected: mustParseTime("2020-02-04T00:00:00Z"),
+			},
+			want: true,
+		},
+		{
+			name: "whole day / last hour of a day longer by DST",
+			cfg: &RepositoryConfig{
+				MergeAvailable: true,
+				TimeZone:       "America/Los_Angeles",
+				Schedules: &MergeChanceSchedules{
+					Sunday: []*MergeChanceSchedule{WholeDay},
+				},
+			},
+			args: args{
+				expected: mustParseTime("2020-11-02T07:30:00Z"),
+			},
+			want: false,
+		},
+		{
+			name: "overnight from Saturday stops on Sunday",
+			cfg: &RepositoryConfig{
+				MergeAvailable: true,
+				Schedules: &MergeChanceSchedules{
+					Saturday: []*MergeChanceSchedule{{
+						StartHour:    22,
+						StopHour:     2,
+						StopsNextDay: true,
+					}},
+				},
+			},
+			args: args{
+				expected: mustParseTime("2020-02-02T02:00:00Z"),
+			},
+			want: true,
+		},
+		{
+			name: "overnight from Saturday still open on Saturday",
+			cfg: &RepositoryConfig{
+				MergeAvailable: true,
+				Schedules: &MergeChanceSchedules{
+					Saturday: []*MergeChanceSchedule{{
+						StartHour:    22,
+						StopHour:     2,
+						StopsNextDay: true,
+					}},
+				},
+			},
+			args: args{
+				expected: mustParseTime("2020-02-01T23:59:00Z"),
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.cfg.ShouldStopOn(tt.args.expected); got != tt.want {
+				t.Errorf("RepositoryConfig.ShouldStopOn() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRepositoryConfig_GatesBaseBranch(t *testing.T) {
+	tests := []struct {
+		name     string
+		patterns []string
+		branch   string
+		want     bool
+	}{
+		{name: "no patterns", patterns: nil, branch: "feature/a", want: true},
+		{name: "exact", patterns: []string{"main", "release/*"}, branch: "main", want: true},
+		{name: "glob", patterns: []string{"main", "release/*"}, branch: "release/1.0", want: true},
+		{name: "glob does not match slashes", patterns: []string{"main", "release/*"}, branch: "release/1.0/hotfix", want: false},
+		{name: "unmatched", patterns: []string{"main", "release/*"}, branch: "feature/a", want: false},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &RepositoryConfig{BaseBranchPatterns: tt.patterns}
+			if got := cfg.GatesBaseBranch(tt.branch); got != tt.want {
+				t.Errorf("RepositoryConfig.GatesBaseBranch() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRepositoryConfig_PullRequestMergeable(t *testing.T) {
+	cfg := &RepositoryConfig{
+		MergeAvailable:     false,
+		BaseBranchPatterns: []string{"main"},
+		BypassLabel:        "hotfix",
+		BranchRules: []*BranchRule{
+			{Pattern: "release/1.0", MergeAvailable: false},
+			{Pattern: "release/*", MergeAvailable: true},
+		},
+	}
+	tests := []struct {
+		name       string
+		baseBranch string
+		labels     []string
+		want       bool
+	}{
+		{name: "repository", baseBranch: "main", want: false},
+		{name: "bypassed", baseBranch: "main", labels: []string{"hotfix"}, want: true},
+		{name: "first matching rule", baseBranch: "release/1.0", want: false},
+		{name: "second rule", baseBranch: "release/2.0", want: true},
+		{name: "not gated", baseBranch: "feature/a", want: true},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			if got := cfg.PullRequestMergeable(tt.baseBranch, tt.labels); got != tt.want {
+				t.Errorf("RepositoryConfig.PullRequestMergeable() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRepositoryConfig_Reconcile(t *testing.T) {
+	weekdays := &MergeChanceSchedules{
+		Monday:  []*MergeChanceSchedule{{StartHour: 10, StopHour: 18}},
+		Tuesday: []*MergeChanceSchedule{{StartHour: 10, StopHour: 18}},
+	}
+	tuesdayMornings := &MergeChanceSchedules{
+		Tuesday: []*MergeChanceSchedule{{StartHour: 10, StopHour: 12}},
+	}
+	tests := []struct {
+		name              string
+		mergeAvailable    bool
+		rulesAvailable    bool
+		at                time.Time
+		wantChanged       bool
+		wantRepository    bool
+		wantRuleAvailable bool
+	}{
+		{name: "all open", mergeAvailable: true, rulesAvailable: true, at: mustParseTime("2020-02-04T11:00:00Z"), wantChanged: false, wantRepository: true, wantRuleAvailable: true},
+		{name: "rule closes", mergeAvailable: true, rulesAvailable: true, at: mustParseTime("2020-02-04T13:00:00Z"), wantChanged: true, wantRepository: true, wantRuleAvailable: false},
+		{name: "rule stays closed", mergeAvailable: true, rulesAvailable: false, at: mustParseTime("2020-02-03T13:00:00Z"), wantChanged: false, wantRepository: true, wantRuleAvailable: false},
+		{name: "both open", mergeAvailable: false, rulesAvailable: false, at: mustParseTime("2020-02-04T10:00:00Z"), wantChanged: true, wantRepository: true, wantRuleAvailable: true},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &RepositoryConfig{
+				Schedules:      weekdays,
+				MergeAvailable: tt.mergeAvailable,
+				BranchRules:    []*BranchRule{{Pattern: "release/*", Schedules: tuesdayMornings, MergeAvailable: tt.rulesAvailable}},
+			}
+			if got := cfg.Reconcile(tt.at); got != tt.wantChanged {
+				t.Errorf("RepositoryConfig.Reconcile() = %v, want %v", got, tt.wantChanged)
+			}
+			if cfg.MergeAvailable != tt.wantRepository {
+				t.Errorf("MergeAvailable = %v, want %v", cfg.MergeAvailable, tt.wantRepository)
+			}
+			if cfg.BranchRules[0].MergeAvailable != tt.wantRuleAvailable {
+				t.Errorf("BranchRules[0].MergeAvailable = %v, want %v", cfg.BranchRules[0].MergeAvailable, tt.wantRuleAvailable)
+			}
+		})
+	}
+}
+
+func TestRepositoryConfig_ReconcileEvents(t *testing.T) {
+	at := mustParseTime("2020-02-04T10:00:00Z") // Tuesday
+	cfg := &RepositoryConfig{
+		Owner:          "aereal",
+		Name:           "example-repo",
+		Schedules:      &MergeChanceSchedules{Tuesday: []*MergeChanceSchedule{{StartHour: 10, StopHour: 18}}},
+		MergeAvailable: false,
+		BranchRules: []*BranchRule{
+			{Pattern: "release/*", Schedules: &MergeChanceSchedules{}, MergeAvailable: true},
+			{Pattern: "hotfix/*", Schedules: &MergeChanceSchedules{}, MergeAvailable: false},
+		},
+	}
+	want := []*AuditEvent{
+		{Owner: "aereal", Name: "example-repo", Type: AuditEventMergeOpened, CreatedAt: at},
+		{Owner: "aereal", Name: "example-repo", Type: AuditEventMergeClosed, BranchPattern: "release/*", CreatedAt: at},
+	}
+	if got := cfg.ReconcileEvents(at); !reflect.DeepEqual(got, want) {
+		t.Errorf("RepositoryConfig.ReconcileEvents() = %#v, want %#v", got, want)
+	}
+	if got := cfg.ReconcileEvents(at); len(got) != 0 {
+		t.Errorf("RepositoryConfig.ReconcileEvents() on reconciled config = %#v", got)
+	}
+}
+
+func TestRepositoryConfig_ConfigUpdatedEvent(t *testing.T) {
+	at := mustParseTime("2020-02-04T10:00:00Z")
+	current := &RepositoryConfig{
+		Owner:       "aereal",
+		Name:        "example-repo",
+		Schedules:   &MergeChanceSchedules{Monday: []*MergeChanceSchedule{{StartHour: 10, StopHour: 18}}},
+		BranchRules: []*BranchRule{{Pattern: "release/*", MergeAvailable: true}},
+	}
+	tests := []struct {
+		name            string
+		before          *RepositoryConfig
+		update          func(cfg *RepositoryConfig)
+		wantDescription string
+	}{
+		{name: "created", before: nil, update: func(cfg *RepositoryConfig) {}, wantDescription: "created"},
+		{name: "unchanged", before: current, update: func(cfg *RepositoryConfig) {}, wantDescription: "changed nothing"},
+		{
+			name:   "schedules and time zone",
+			before: current,
+			update: func(cfg *RepositoryConfig) {
+				cfg.Schedules = &MergeChanceSchedules{Monday: []*MergeChanceSchedule{{StartHour: 9, StopHour: 18}}}
+				cfg.TimeZone = "Asia/Tokyo"
+			},
+			wantDescription: "changed schedules, timeZone",
+		},
+		{
+			name:   "branch rule states only",
+			before: current,
+			update: func(cfg *RepositoryConfig) {
+				cfg.BranchRules = []*BranchRule{{Pattern: "release/*", MergeAvailable: false}}
+			},
+			wantDescription: "changed nothing",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			updated := *current
+			tt.update(&updated)
+			got := updated.ConfigUpdatedEvent(tt.before, "octocat", at)
+			want := &AuditEvent{Owner: "aereal", Name: "example-repo", Type: AuditEventConfigUpdated, Actor: "octocat", Description: tt.wantDescription, CreatedAt: at}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("RepositoryConfig.ConfigUpdatedEvent() = %#v, want %#v", got, want)
+			}
+		})
+	}
+}
+
+func TestRepositoryConfig_OverrideExpiredEvent(t *testing.T) {
+	at := mustParseTime("2020-02-04T10:00:00Z")
+	cfg := &RepositoryConfig{
+		Owner:    "aereal",
+		Name:     "example-repo",
+		Override: &Override{MergeAvailable: true, Until: at, CreatedBy: "octocat", CreatedAt: mustParseTime("2020-02-04T09:00:00Z")},
+	}
+	got := cfg.OverrideExpiredEvent(at)
+	want := &AuditEvent{Owner: "aereal", Name: "example-repo", Type: AuditEventOverrideExpired, Description: "forced open by octocat until 2020-02-04T10:00:00Z", CreatedAt: at}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("RepositoryConfig.OverrideExpiredEvent() = %#v, want %#v", got, want)
+	}
+}
+
+func TestRepositoryConfig_NextTransition(t *testing.T) {
+	weekdays := &MergeChanceSchedules{
+		Monday:  []*MergeChanceSchedule{{StartHour: 10, StopHour: 18}},
+		Tuesday: []*MergeChanceSchedule{{StartHour: 10, StopHour: 18}},
+	}
+	tests := []struct {
+		name     string
+		cfg      *RepositoryConfig
+		branch   string
+		at       time.Time
+		want     time.Time
+		wantNext bool
+	}{
+		{
+			name:     "opens",
+			cfg:      &RepositoryConfig{Schedules: weekdays},
+			at:       mustParseTime("2020-02-03T09:00:00Z"),
+			want:     mustParseTime("2020-02-03T10:00:00Z"),
+			wantNext: true,
+		},
+		{
+			name:     "closes",
+			cfg:      &RepositoryConfig{Schedules: weekdays},
+			at:       mustParseTime("2020-02-03T10:00:00Z"),
+			want:     mustParseTime("2020-02-03T18:00:00Z"),
+			wantNext: true,
+		},
+		{
+			name:     "opens next week",
+			cfg:      &RepositoryConfig{Schedules: weekdays},
+			at:       mustParseTime("2020-02-04T18:00:00Z"),
+			want:     mustParseTime("2020-02-10T10:00:00Z"),
+			wantNext: true,
+		},
+		{
+			name:     "whole days",
+			cfg:      &RepositoryConfig{Schedules: &MergeChanceSchedules{Monday: []*MergeChanceSchedule{WholeDay}, Tuesday: []*MergeChanceSchedule{WholeDay}}},
+			at:       mustParseTime("2020-02-03T12:00:00Z"),
+			want:     mustParseTime("2020-02-05T00:00:00Z"),
+			wantNext: true,
+		},
+		{
+			name:     "contiguous windows",
+			cfg:      &RepositoryConfig{Schedules: &MergeChanceSchedules{Monday: []*MergeChanceSchedule{{StartHour: 10, StopHour: 12}, {StartHour: 12, StopHour: 18}}}},
+			at:       mustParseTime("2020-02-03T11:00:00Z"),
+			want:     mustParseTime("2020-02-03T18:00:00Z"),
+			wantNext: true,
+		},
+		{
+			name:     "in time zone",
+			cfg:      &RepositoryConfig{Schedules: weekdays, TimeZone: "Asia/Tokyo"},
+			at:       mustParseTime("2020-02-03T00:00:00Z"),
+			want:     mustParseTime("2020-02-03T01:00:00Z"),
+			wantNext: true,
+		},
+		{
+			name: "skips exception date",
+			cfg: &RepositoryConfig{Schedules: weekdays, Calendar: &Calendar{
+				ExceptionDates: []*ExceptionDate{{Date: Date{Year: 2020, Month: time.February, Day: 3}}},
+			}},
+			at:       mustParseTime("2020-02-03T09:00:00Z"),
+			want:     mustParseTime("2020-02-04T10:00:00Z"),
+			wantNext: true,
+		},
+		{
+			name: "freeze period ends",
+			cfg: &RepositoryConfig{Schedules: weekdays, OwnerCalendar: &Calendar{
+				FreezePeriods: []*FreezePeriod{{Start: mustParseTime("2020-02-03T09:00:00Z"), End: mustParseTime("2020-02-03T13:00:00Z")}},
+			}},
+			at:       mustParseTime("2020-02-03T11:00:00Z"),
+			want:     mustParseTime("2020-02-03T13:00:00Z"),
+			wantNext: true,
+		},
+		{
+			name:     "override expires",
+			cfg:      &RepositoryConfig{Schedules: weekdays, Override: &Override{MergeAvailable: true, Until: mustParseTime("2020-02-01T15:00:00Z")}},
+			at:       mustParseTime("2020-02-01T12:00:00Z"),
+			want:     mustParseTime("2020-02-01T15:00:00Z"),
+			wantNext: true,
+		},
+		{
+			name:     "branch rule",
+			cfg:      &RepositoryConfig{Schedules: weekdays, BranchRules: []*BranchRule{{Pattern: "release/*", Schedules: &MergeChanceSchedules{Friday: []*MergeChanceSchedule{{StartHour: 13, StopHour: 15}}}}}},
+			branch:   "release/1.0",
+			at:       mustParseTime("2020-02-03T09:00:00Z"),
+			want:     mustParseTime("2020-02-07T13:00:00Z"),
+			wantNext: true,
+		},
+		{
+			name:     "no schedules",
+			cfg:      &RepositoryConfig{Schedules: &MergeChanceSchedules{}},
+			at:       mustParseTime("2020-02-03T09:00:00Z"),
+			wantNext: false,
+		},
+		{
+			name:     "overnight opens on Saturday",
+			cfg:      &RepositoryConfig{Schedules: &MergeChanceSchedules{Saturday: []*MergeChanceSchedule{{StartHour: 22, StopHour: 2, StopsNextDay: true}}}},
+			at:       mustParseTime("2020-02-01T12:00:00Z"),
+			want:     mustParseTime("2020-02-01T22:00:00Z"),
+			wantNext: true,
+		},
+		{
+			name:     "overnight closes on Sunday",
+			cfg:      &RepositoryConfig{Schedules: &MergeChanceSchedules{Saturday: []*MergeChanceSchedule{{StartHour: 22, StopHour: 2, StopsNextDay: true}}}},
+			at:       mustParseTime("2020-02-02T00:30:00Z"),
+			want:     mustParseTime("2020-02-02T02:00:00Z"),
+			wantNext: true,
+		},
+		{
+			name:     "overnight continues into the next window",
+			cfg:      &RepositoryConfig{Schedules: &MergeChanceSchedules{Saturday: []*MergeChanceSchedule{{StartHour: 22, StopHour: 2, StopsNextDay: true}}, Sunday: []*MergeChanceSchedule{{StartHour: 2, StopHour: 6}}}},
+			at:       mustParseTime("2020-02-01T23:00:00Z"),
+			want:     mustParseTime("2020-02-02T06:00:00Z"),
+			wantNext: true,
+		},
+		{
+			name:     "overnight in time zone",
+			cfg:      &RepositoryConfig{Schedules: &MergeChanceSchedules{Saturday: []*MergeChanceSchedule{{StartHour: 22, StopHour: 2, StopsNextDay: true}}}, TimeZone: "Asia/Tokyo"},
+			at:       mustParseTime("2020-02-01T14:00:00Z"),
+			want:     mustParseTime("2020-02-01T17:00:00Z"),
+			wantNext: true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			branch := tt.branch
+			if branch == "" {
+				branch = "main"
+			}
+			got, ok := tt.cfg.NextTransition(branch, tt.at)
+			if ok != tt.wantNext {
+				t.Fatalf("RepositoryConfig.NextTransition() ok = %v, want %v", ok, tt.wantNext)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("RepositoryConfig.NextTransition() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRepositoryConfig_StatusContextName(t *testing.T) {
+	tests := []struct {
+		name        string
+		cfg         *RepositoryConfig
+		wantContext string
+		wantOpen    string
+		wantClosed  string
+	}{
+		{name: "defaults", cfg: &RepositoryConfig{}, wantContext: "merge-chance-time", wantOpen: "open", wantClosed: "closed"},
+		{name: "configured", cfg: &RepositoryConfig{StatusContext: "release-freeze", OpenDescription: "no freeze", ClosedDescription: "frozen"}, wantContext: "release-freeze", wantOpen: "no freeze", wantClosed: "frozen"},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.cfg.StatusContextName(); got != tt.wantContext {
+				t.Errorf("RepositoryConfig.StatusContextName() = %q, want %q", got, tt.wantContext)
+			}
+			if got := tt.cfg.StatusDescription(true); got != tt.wantOpen {
+				t.Errorf("RepositoryConfig.StatusDescription(true) = %q, want %q", got, tt.wantOpen)
+			}
+			if got := tt.cfg.StatusDescription(false); got != tt.wantClosed {
+				t.Errorf("RepositoryConfig.StatusDescription(false) = %q, want %q", got, tt.wantClosed)
+			}
+		})
+	}
+}
+
+func TestRepositoryConfig_Valid(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     *RepositoryConfig
+		wantErr bool
+	}{
+		{
+			name:    "OK",
+			cfg:     &RepositoryConfig{Owner: "aereal", Name: "example-repo", TimeZone: "Asia/Tokyo"},
+			wantErr: false,
+		},
+		{
+			name:    "no time zone",
+			cfg:     &RepositoryConfig{Owner: "aereal", Name: "example-repo"},
+			wantErr: false,
+		},
+		{
+			name:    "base branch patterns",
+			cfg:     &RepositoryConfig{Owner: "aereal", Name: "example-repo", BaseBranchPatterns: []string{"main", "release/*"}},
+			wantErr: false,
+		},
+		{
+			name: "invalid branch rule",
+			cfg: &RepositoryConfig{
+				Owner:       "aereal",
+				Name:        "example-repo",
+				BranchRules: []*BranchRule{{Pattern: ""}},
+			},
+			wantErr: true,
+		},
+		{
+			name:    "status context and descriptions",
+			cfg:     &RepositoryConfig{Owner: "aereal", Name: "example-repo", StatusContext: "release-freeze", OpenDescription: "no freeze", ClosedDescription: "frozen"},
+			wantErr: false,
+		},
+		{
+			name:    "too long status context",
+			cfg:     &RepositoryConfig{Owner: "aereal", Name: "example-repo", StatusContext: strings.Repeat("x", 101)},
+			wantErr: true,
+		},
+		{
+			name:    "too long description",
+			cfg:     &RepositoryConfig{Owner: "aereal", Name: "example-repo", ClosedDescription: strings.Repeat("x", 101)},
+			wantErr: true,
+		},
+		{
+			name:    "invalid base branch pattern",
+			cfg:     &RepositoryConfig{Owner: "aereal", Name: "example-repo", BaseBranchPatterns: []string{"release/["}},
+			wantErr: true,
+		},
+		{
+			name: "multiple windows",
+			cfg: &RepositoryConfig{
+				Owner: "aereal",
+				Name:  "example-repo",
+				Schedules: &MergeChanceSchedules{
+					Monday: []*MergeChanceSchedule{
+						{StartHour: 13, StopHour: 18},
+						{StartHour: 10, StopHour: 12},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "overlapping windows",
+			cfg: &RepositoryConfig{
+				Owner: "aereal",
+				Name:  "example-repo",
+				Schedules: &MergeChanceSchedules{
+					Monday: []*MergeChanceSchedule{
+						{StartHour: 10, StopHour: 12, StopMinute: 30},
+						{StartHour: 12, StopHour: 18},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name:    "unknown time zone",
+			cfg:     &RepositoryConfig{Owner: "aereal", Name: "example-repo", TimeZone: "Mars/Olympus_Mons"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.cfg.Valid(); (err != nil) != tt.wantErr {
+				t.Errorf("RepositoryConfig.Valid() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRepositoryConfig_Valid_fieldErrors(t *testing.T) {
+	tests := []struct {
+		name      string
+		schedules *MergeChanceSchedules
+		rules     []*BranchRule
+		want      []*FieldError
+	}{
+		{
+			name: "valid",
+			schedules: &MergeChanceSchedules{
+				Monday: []*MergeChanceSchedule{{StartHour: 0, StopHour: 23, StopMinute: 59}},
+			},
+			want: nil,
+		},
+		{
+			name: "out of range",
+			schedules: &MergeChanceSchedules{
+				Monday: []*MergeChanceSchedule{{StartHour: -1, StartMinute: 60, StopHour: 24}},
+			},
+			want: []*FieldError{
+				{Field: "schedules.monday[0].startHour", Message: "must be between 0 and 23"},
+				{Field: "schedules.monday[0].startMinute", Message: "must be between 0 and 59"},
+				{Field: "schedules.monday[0].stopHour", Message: "must be between 0 and 23"},
+			},
+		},
+		{
+			name: "empty window",
+			schedules: &MergeChanceSchedules{
+				Tuesday: []*MergeChanceSchedule{{StartHour: 10, StartMinute: 30, StopHour: 10, StopMinute: 30}},
+			},
+			want: []*FieldError{
+				{Field: "schedules.tuesday[0]", Message: "must not start and stop at the same time"},
+			},
+		},
+		{
+			name: "stop before start",
+			schedules: &MergeChanceSchedules{
+				Friday: []*MergeChanceSchedule{{StartHour: 22, StopHour: 2}},
+			},
+			want: []*FieldError{
+				{Field: "schedules.friday[0]", Message: "must not stop before it starts unless it stops on the next day"},
+			},
+		},
+		{
+			name: "overnight",
+			schedules: &MergeChanceSchedules{
+				Friday:   []*MergeChanceSchedule{{StartHour: 22, StopHour: 2, StopsNextDay: true}},
+				Saturday: []*MergeChanceSchedule{{StartHour: 2, StopHour: 2, StopsNextDay: true}},
+			},
+			want: nil,
+		},
+		{
+			name: "overnight longer than a day",
+			schedules: &MergeChanceSchedules{
+				Friday: []*MergeChanceSchedule{{StartHour: 10, StopHour: 11, StopsNextDay: true}},
+			},
+			want: []*FieldError{
+				{Field: "schedules.friday[0]", Message: "must not last longer than a day"},
+			},
+		},
+		{
+			name: "overnight overlapping the next day",
+			schedules: &MergeChanceSchedules{
+				Monday:  []*MergeChanceSchedule{{StartHour: 22, StopHour: 2, StopsNextDay: true}},
+				Tuesday: []*MergeChanceSchedule{{StartHour: 1, StopHour: 5}},
+			},
+			want: []*FieldError{
+				{Field: "schedules.tuesday[0]", Message: "overlaps schedules.monday[0]"},
+			},
+		},
+		{
+			name: "overnight on Saturday overlapping Sunday",
+			schedules: &MergeChanceSchedules{
+				Sunday:   []*MergeChanceSchedule{{StartHour: 1, StopHour: 5}},
+				Saturday: []*MergeChanceSchedule{{StartHour: 22, StopHour: 2, StopsNextDay: true}},
+			},
+			want: []*FieldError{
+				{Field: "schedules.sunday[0]", Message: "overlaps schedules.saturday[0]"},
+			},
+		},
+		{
+			name: "overnight on Saturday followed by Sunday",
+			schedules: &MergeChanceSchedules{
+				Sunday:   []*MergeChanceSchedule{{StartHour: 2, StopHour: 5}},
+				Saturday: []*MergeChanceSchedule{{StartHour: 22, StopHour: 2, StopsNextDay: true}},
+			},
+			want: nil,
+		},
+		{
+			name: "overlap",
+			schedules: &MergeChanceSchedules{
+				Wednesday: []*MergeChanceSchedule{
+					{StartHour: 13, StopHour: 18},
+					{StartHour: 25, StopHour: 26},
+					{StartHour: 10, StopHour: 14},
+				},
+			},
+			want: []*FieldError{
+				{Field: "schedules.wednesday[1].startHour", Message: "must be between 0 and 23"},
+				{Field: "schedules.wednesday[1].stopHour", Message: "must be between 0 and 23"},
+				{Field: "schedules.wednesday[0]", Message: "overlaps schedules.wednesday[2]"},
+			},
+		},
+		{
+			name: "branch rule",
+			rules: []*BranchRule{
+				{Pattern: "main"},
+				{Pattern: "", Schedules: &MergeChanceSchedules{Sunday: []*MergeChanceSchedule{{StartHour: 9, StopHour: 9}}}},
+			},
+			want: []*FieldError{
+				{Field: "branchRules[1].pattern", Message: "must not be empty"},
+				{Field: "branchRules[1].schedules.sunday[0]", Message: "must not start and stop at the same time"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &RepositoryConfig{Owner: "aereal", Name: "example-repo", Schedules: tt.schedules, BranchRules: tt.rules}
+			err := cfg.Valid()
+			if tt.want == nil {
+				if err != nil {
+					t.Errorf("RepositoryConfig.Valid() error = %v", err)
+				}
+				return
+			}
+			var verr *ValidationError
+			if !errors.As(err, &verr) {
+				t.Fatalf("RepositoryConfig.Valid() error = %v, want *ValidationError", err)
+			}
+			if !reflect.DeepEqual(verr.Errors, tt.want) {
+				t.Errorf("RepositoryConfig.Valid() errors:\n got: %s\nwant: %s", fieldErrorsString(verr.Errors), fieldErrorsString(tt.want))
+			}
+		})
+	}
+}
+
+func fieldErrorsString(errs []*FieldError) string {
+	msgs := make([]string, len(errs))
+	for i, e := range errs {
+		msgs[i] = e.Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+func TestCalendar_AddExceptionDate(t *testing.T) {
+	calendar := &Calendar{}
+	calendar.AddExceptionDate(&ExceptionDate{Date: Date{Year: 2020, Month: time.December, Day: 31}, Reason: "year end"})
+	calendar.AddExceptionDate(&ExceptionDate{Date: Date{Year: 2020, Month: time.January, Day: 1}, Reason: "new year"})
+	calendar.AddExceptionDate(&ExceptionDate{Date: Date{Year: 2020, Month: time.December, Day: 31}, Reason: "freeze"})
+	want := []*ExceptionDate{
+		{Date: Date{Year: 2020, Month: time.January, Day: 1}, Reason: "new year"},
+		{Date: Date{Year: 2020, Month: time.December, Day: 31}, Reason: "freeze"},
+	}
+	if !reflect.DeepEqual(calendar.ExceptionDates, want) {
+		t.Errorf("Calendar.ExceptionDates = %#v, want %#v", calendar.ExceptionDates, want)
+	}
+	if !calendar.RemoveExceptionDate(Date{Year: 2020, Month: time.January, Day: 1}) {
+		t.Error("RemoveExceptionDate() returned false")
+	}
+	if calendar.RemoveExceptionDate(Date{Year: 2020, Month: time.January, Day: 1}) {
+		t.Error("RemoveExceptionDate() returned true for removed date")
+	}
+}
+
+func TestPermissionOf(t *testing.T) {
+	tests := []struct {
+		permissions map[string]bool
+		want        Permission
+	}{
+		{map[string]bool{"admin": true, "maintain": true, "push": true, "triage": true, "pull": true}, PermissionAdmin},
+		{map[string]bool{"admin": false, "maintain": true, "push": true, "triage": true, "pull": true}, PermissionMaintain},
+		{map[string]bool{"admin": false, "push": true, "pull": true}, PermissionWrite},
+		{map[string]bool{"admin": false, "push": false, "triage": true, "pull": true}, PermissionTriage},
+		{map[string]bool{"admin": false, "push": false, "pull": true}, PermissionRead},
+		{map[string]bool{}, PermissionNone},
+	}
+	for _, tt := range tests {
+		if got := PermissionOf(tt.permissions); got != tt.want {
+			t.Errorf("PermissionOf(%v) = %s, want %s", tt.permissions, got, tt.want)
+		}
+	}
+}
+
+func TestParsePermission(t *testing.T) {
+	tests := []struct {
+		s       string
+		want    Permission
+		wantErr bool
+	}{
+		{s: "admin", want: PermissionAdmin},
+		{s: "maintain", want: PermissionMaintain},
+		{s: "write", want: PermissionWrite},
+		{s: "push", want: PermissionWrite},
+		{s: "pull", want: PermissionRead},
+		{s: "none", wantErr: true},
+		{s: "owner", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := ParsePermission(tt.s)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParsePermission(%q) error = %v, wantErr %v", tt.s, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ParsePermission(%q) = %s, want %s", tt.s, got, tt.want)
+		}
 	}
 }
@@ -0,0 +1,121 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	"github.com/aereal/merge-chance-time/domain/model"
+	"google.golang.org/api/iterator"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	ErrInvalidCursor = fmt.Errorf("invalid cursor")
+)
+
+func (r *repoImpl) auditEvents(owner, name string) *firestore.CollectionRef {
+	return r.firestoreClient.Collection("InstallationTarget").Doc(owner).Collection("Repository").Doc(name).Collection("AuditEvent")
+}
+
+// AddAuditEvents stores the events and sets their IDs.
+// The batches are committed one by one, so the events in the committed batches are stored even if a later batch fails.
+func (r *repoImpl) AddAuditEvents(ctx context.Context, events []*model.AuditEvent) error {
+	for _, chunk := range chunkAuditEvents(events, maxBatchWrites) {
+		batch := r.firestoreClient.Batch()
+		for _, event := range chunk {
+			ref := r.auditEvents(event.Owner, event.Name).NewDoc()
+			batch.Create(ref, newDTOAuditEventFromModel(event))
+			event.ID = ref.ID
+		}
+		if _, err := batch.Commit(ctx); err != nil {
+			return fmt.Errorf("failed to add audit events: %w", err)
+		}
+	}
+	return nil
+}
+
+// chunkAuditEvents splits the events into chunks of at most size events.
+func chunkAuditEvents(events []*model.AuditEvent, size int) [][]*model.AuditEvent {
+	chunks := [][]*model.AuditEvent{}
+	for len(events) > size {
+		chunks = append(chunks, events[:size])
+		events = events[size:]
+	}
+	if len(events) > 0 {
+		chunks = append(chunks, events)
+	}
+	return chunks
+}
+
+// ListAuditEvents returns at most first audit events of the repository from the newest, following the event whose ID is after if it is not empty.
+// It also reports whether older events remain.
+func (r *repoImpl) ListAuditEvents(ctx context.Context, owner, name string, first int, after string) ([]*model.AuditEvent, bool, error) {
+	coll := r.auditEvents(owner, name)
+	query := coll.OrderBy("CreatedAt", firestore.Desc).OrderBy(firestore.DocumentID, firestore.Desc).Limit(first + 1)
+	if after != "" {
+		cursor, err := coll.Doc(after).Get(ctx)
+		if status.Code(err) == codes.NotFound {
+			return nil, false, ErrInvalidCursor
+		}
+		if err != nil {
+			return nil, false, fmt.Errorf("failed to fetch the cursor of audit events: %w", err)
+		}
+		query = query.StartAfter(cursor)
+	}
+
+	events := []*model.AuditEvent{}
+	iter := query.Documents(ctx)
+	defer iter.Stop()
+	for {
+		snapshot, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, false, fmt.Errorf("failed to list audit events of %s/%s: %w", owner, name, err)
+		}
+		var dto dtoAuditEvent
+		if err := snapshot.DataTo(&dto); err != nil {
+			return nil, false, err
+		}
+		events = append(events, dto.toModel(owner, name, snapshot.Ref.ID))
+	}
+	if len(events) > first {
+		return events[:first], true, nil
+	}
+	return events, false, nil
+}
+
+type dtoAuditEvent struct {
+	Type          string
+	Actor         string
+	BranchPattern string
+	Description   string
+	CreatedAt     time.Time
+}
+
+func newDTOAuditEventFromModel(e *model.AuditEvent) *dtoAuditEvent {
+	return &dtoAuditEvent{
+		Type:          string(e.Type),
+		Actor:         e.Actor,
+		BranchPattern: e.BranchPattern,
+		Description:   e.Description,
+		CreatedAt:     e.CreatedAt,
+	}
+}
+
+func (d *dtoAuditEvent) toModel(owner, name, id string) *model.AuditEvent {
+	return &model.AuditEvent{
+		ID:            id,
+		Owner:         owner,
+		Name:          name,
+		Type:          model.AuditEventType(d.Type),
+		Actor:         d.Actor,
+		BranchPattern: d.BranchPattern,
+		Description:   d.Description,
+		CreatedAt:     d.CreatedAt,
+	}
+}
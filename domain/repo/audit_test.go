@@ -0,0 +1,48 @@
+package repo
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aereal/merge-chance-time/domain/model"
+)
+
+func Test_chunkAuditEvents(t *testing.T) {
+	tests := []struct {
+		name string
+		n    int
+		want []int
+	}{
+		{name: "empty", n: 0, want: []int{}},
+		{name: "single", n: 1, want: []int{1}},
+		{name: "full batch", n: maxBatchWrites, want: []int{maxBatchWrites}},
+		{name: "over a batch", n: maxBatchWrites + 1, want: []int{maxBatchWrites, 1}},
+		{name: "several batches", n: maxBatchWrites*2 + 3, want: []int{maxBatchWrites, maxBatchWrites, 3}},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			events := make([]*model.AuditEvent, tt.n)
+			for i := range events {
+				events[i] = &model.AuditEvent{Owner: "aereal", Name: "example-repo", Type: model.AuditEventMergeOpened}
+			}
+			got := []int{}
+			var seen []*model.AuditEvent
+			for _, chunk := range chunkAuditEvents(events, maxBatchWrites) {
+				got = append(got, len(chunk))
+				seen = append(seen, chunk...)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("chunkAuditEvents() sizes = %v, want %v", got, tt.want)
+			}
+			if len(seen) != len(events) {
+				t.Fatalf("chunkAuditEvents() has %d events, want %d", len(seen), len(events))
+			}
+			for i := range seen {
+				if seen[i] != events[i] {
+					t.Errorf("chunkAuditEvents()[%d] is not the event #%d", i, i)
+				}
+			}
+		})
+	}
+}
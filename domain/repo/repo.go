@@ -4,7 +4,9 @@ package repo
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	"cloud.google.com/go/firestore"
 	"github.com/aereal/merge-chance-time/domain/model"
@@ -15,6 +17,15 @@ import (
 
 var (
 	ErrNotFound = fmt.Errorf("not found")
+	// ErrConflict is returned if the stored config has been updated since it was read.
+	ErrConflict = fmt.Errorf("updated since it was read")
+)
+
+const (
+	// maxBatchWrites is the maximum number of writes in a batch of Firestore.
+	maxBatchWrites = 500
+	// writesPerConfig is the number of writes to put a config, which are the owner and the repository.
+	writesPerConfig = 2
 )
 
 func New(firestoreClient *firestore.Client) (Repository, error) {
@@ -30,8 +41,14 @@ type Repository interface {
 	DeleteRepositoryConfig(ctx context.Context, owner, name string) error
 	DeleteRepositoryConfigsByOwner(ctx context.Context, owner string) error
 	PutRepositoryConfigs(ctx context.Context, configs []*model.RepositoryConfig) error
+	UpdateRepositoryConfig(ctx context.Context, config *model.RepositoryConfig) error
+	UpdateMergeChanceStates(ctx context.Context, config *model.RepositoryConfig) error
 	GetRepositoryConfig(ctx context.Context, owner, name string) (*model.RepositoryConfig, error)
 	ListConfigsByOwners(ctx context.Context) (map[string][]*model.RepositoryConfig, error)
+	GetOwnerCalendar(ctx context.Context, owner string) (*model.Calendar, error)
+	UpdateOwnerCalendar(ctx context.Context, owner string, calendar *model.Calendar) error
+	AddAuditEvents(ctx context.Context, events []*model.AuditEvent) error
+	ListAuditEvents(ctx context.Context, owner, name string, first int, after string) ([]*model.AuditEvent, bool, error)
 }
 
 type repoImpl struct {
@@ -58,34 +75,139 @@ func (r *repoImpl) DeleteRepositoryConfigsByOwner(ctx context.Context, owner str
 func (r *repoImpl) PutRepositoryConfigs(ctx context.Context, configs []*model.RepositoryConfig) error {
 	dtos := []*dtoRepositoryConfig{}
 	for _, config := range configs {
-		dto := &dtoRepositoryConfig{
-			Owner:          config.Owner,
-			Name:           config.Name,
-			MergeAvailable: config.MergeAvailable,
-			Schedules:      newDTOMergeChanceSchedulesFromModel(config.Schedules),
+		dtos = append(dtos, newDTORepositoryConfigFromModel(config))
+	}
+	// the batches are committed one by one, so the configs in the committed batches are stored even if a later batch fails
+	for _, chunk := range chunkDTOs(dtos, maxBatchWrites/writesPerConfig) {
+		batch := r.firestoreClient.Batch()
+		for _, dto := range chunk {
+			ownerRef := r.firestoreClient.Collection("InstallationTarget").Doc(dto.Owner)
+			repoRef := ownerRef.Collection("Repository").Doc(dto.Name)
+			batch.Set(ownerRef, map[string]interface{}{}, firestore.MergeAll)
+			batch.Set(repoRef, dto)
+		}
+		if _, err := batch.Commit(ctx); err != nil {
+			return err
 		}
-		dtos = append(dtos, dto)
 	}
-	batch := r.firestoreClient.Batch()
-	for _, dto := range dtos {
-		ownerRef := r.firestoreClient.Collection("InstallationTarget").Doc(dto.Owner)
-		repoRef := ownerRef.Collection("Repository").Doc(dto.Name)
-		batch.Set(ownerRef, map[string]interface{}{})
-		batch.Set(repoRef, dto)
+	return nil
+}
+
+// chunkDTOs splits the DTOs into chunks of at most size DTOs.
+func chunkDTOs(dtos []*dtoRepositoryConfig, size int) [][]*dtoRepositoryConfig {
+	chunks := [][]*dtoRepositoryConfig{}
+	for len(dtos) > size {
+		chunks = append(chunks, dtos[:size])
+		dtos = dtos[size:]
+	}
+	if len(dtos) > 0 {
+		chunks = append(chunks, dtos)
+	}
+	return chunks
+}
+
+// UpdateRepositoryConfig stores the config read from the store, or creates it if its UpdateTime is zero.
+// It returns ErrConflict if the stored config has been created, updated or deleted since the config was read.
+func (r *repoImpl) UpdateRepositoryConfig(ctx context.Context, config *model.RepositoryConfig) error {
+	ownerRef := r.firestoreClient.Collection("InstallationTarget").Doc(config.Owner)
+	repoRef := ownerRef.Collection("Repository").Doc(config.Name)
+	dto := newDTORepositoryConfigFromModel(config)
+	// Set does not take preconditions, so the update time is compared in the transaction instead
+	err := r.firestoreClient.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		snapshot, err := tx.Get(repoRef)
+		if err != nil && status.Code(err) != codes.NotFound {
+			return err
+		}
+		if !snapshot.UpdateTime.Equal(config.UpdateTime) {
+			return ErrConflict
+		}
+		if err := tx.Set(ownerRef, map[string]interface{}{}, firestore.MergeAll); err != nil {
+			return err
+		}
+		return tx.Set(repoRef, dto)
+	})
+	if errors.Is(err, ErrConflict) {
+		return ErrConflict
+	}
+	if err != nil {
+		return fmt.Errorf("failed to update RepositoryConfig: %w", err)
+	}
+	return nil
+}
+
+func newDTORepositoryConfigFromModel(config *model.RepositoryConfig) *dtoRepositoryConfig {
+	return &dtoRepositoryConfig{
+		Owner:              config.Owner,
+		Name:               config.Name,
+		TimeZone:           config.TimeZone,
+		MergeAvailable:     config.MergeAvailable,
+		Schedules:          newDTOMergeChanceSchedulesFromModel(config.Schedules),
+		ExceptionDates:     newDTOExceptionDatesFromModel(config.Calendar),
+		FreezePeriods:      newDTOFreezePeriodsFromModel(config.Calendar),
+		Override:           newDTOOverrideFromModel(config.Override),
+		BypassLabel:        config.BypassLabel,
+		BaseBranchPatterns: config.BaseBranchPatterns,
+		BranchRules:        newDTOBranchRulesFromModel(config.BranchRules),
+		StatusContext:      config.StatusContext,
+		OpenDescription:    config.OpenDescription,
+		ClosedDescription:  config.ClosedDescription,
+		QuarantinedAt:      config.QuarantinedAt,
+		FeedGeneration:     config.FeedGeneration,
+	}
+}
+
+// UpdateMergeChanceStates stores the merge chance states of the repository and its branch rules, the override and the quarantine of the config,
+// and leaves the other fields as stored.
+// It returns ErrConflict if the stored config has been updated since the config was read, and ErrNotFound if it has been deleted.
+func (r *repoImpl) UpdateMergeChanceStates(ctx context.Context, config *model.RepositoryConfig) error {
+	ref := r.firestoreClient.Collection("InstallationTarget").Doc(config.Owner).Collection("Repository").Doc(config.Name)
+	precondition := firestore.Exists
+	if !config.UpdateTime.IsZero() {
+		precondition = firestore.LastUpdateTime(config.UpdateTime)
+	}
+	_, err := ref.Update(ctx, mergeChanceStateUpdates(config), precondition)
+	switch status.Code(err) {
+	case codes.OK:
+		return nil
+	case codes.FailedPrecondition:
+		return ErrConflict
+	case codes.NotFound:
+		return ErrNotFound
+	default:
+		return fmt.Errorf("failed to update RepositoryConfig: %w", err)
+	}
+}
+
+// mergeChanceStateUpdates returns the updates of the fields changed by reconciling the config.
+func mergeChanceStateUpdates(config *model.RepositoryConfig) []firestore.Update {
+	return []firestore.Update{
+		{Path: "MergeAvailable", Value: config.MergeAvailable},
+		{Path: "BranchRules", Value: newDTOBranchRulesFromModel(config.BranchRules)},
+		{Path: "Override", Value: newDTOOverrideFromModel(config.Override)},
+		{Path: "QuarantinedAt", Value: config.QuarantinedAt},
 	}
-	_, err := batch.Commit(ctx)
-	return err
 }
 
 func (r *repoImpl) GetRepositoryConfig(ctx context.Context, owner, name string) (*model.RepositoryConfig, error) {
-	snapshot, err := r.firestoreClient.Collection("InstallationTarget").Doc(owner).Collection("Repository").Doc(name).Get(ctx)
-	if status.Code(err) == codes.NotFound {
+	ownerRef := r.firestoreClient.Collection("InstallationTarget").Doc(owner)
+	repoRef := ownerRef.Collection("Repository").Doc(name)
+	snapshots, err := r.firestoreClient.GetAll(ctx, []*firestore.DocumentRef{ownerRef, repoRef})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch RepositoryConfig: %w", err)
+	}
+	ownerSnapshot, repoSnapshot := snapshots[0], snapshots[1]
+	if !repoSnapshot.Exists() {
 		return nil, ErrNotFound
 	}
+	cfg, err := repoFrom(repoSnapshot)
 	if err != nil {
-		return nil, fmt.Errorf("failed to fetch RepositoryConfig: %w", err)
+		return nil, err
 	}
-	return repoFrom(snapshot)
+	cfg.OwnerCalendar, err = ownerCalendarFrom(ownerSnapshot)
+	if err != nil {
+		return nil, err
+	}
+	return cfg, nil
 }
 
 func (r *repoImpl) ListConfigsByOwners(ctx context.Context) (map[string][]*model.RepositoryConfig, error) {
@@ -99,17 +221,61 @@ func (r *repoImpl) ListConfigsByOwners(ctx context.Context) (map[string][]*model
 		if err != nil {
 			return nil, err
 		}
+		calendar, err := ownerCalendarFrom(ownerSnapshot)
+		if err != nil {
+			return nil, err
+		}
 		repoIter := ownerSnapshot.Ref.Collection("Repository").Documents(ctx)
 		cfgs, err := fetchRepoConfigs(ctx, repoIter)
 		if err != nil {
 			return nil, err
 		}
+		for _, cfg := range cfgs {
+			cfg.OwnerCalendar = calendar
+		}
 		ownerName := ownerSnapshot.Ref.ID
 		configs[ownerName] = cfgs
 	}
 	return configs, nil
 }
 
+func (r *repoImpl) GetOwnerCalendar(ctx context.Context, owner string) (*model.Calendar, error) {
+	snapshot, err := r.firestoreClient.Collection("InstallationTarget").Doc(owner).Get(ctx)
+	if status.Code(err) == codes.NotFound {
+		return &model.Calendar{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch InstallationTarget: %w", err)
+	}
+	return ownerCalendarFrom(snapshot)
+}
+
+// UpdateOwnerCalendar stores the calendar of the owner read by GetOwnerCalendar.
+// It returns ErrConflict if the owner has been updated since the calendar was read.
+func (r *repoImpl) UpdateOwnerCalendar(ctx context.Context, owner string, calendar *model.Calendar) error {
+	ref := r.firestoreClient.Collection("InstallationTarget").Doc(owner)
+	var err error
+	if calendar.UpdateTime.IsZero() {
+		_, err = ref.Create(ctx, &dtoInstallationTarget{
+			ExceptionDates: newDTOExceptionDatesFromModel(calendar),
+			FreezePeriods:  newDTOFreezePeriodsFromModel(calendar),
+		})
+	} else {
+		_, err = ref.Update(ctx, []firestore.Update{
+			{Path: "ExceptionDates", Value: newDTOExceptionDatesFromModel(calendar)},
+			{Path: "FreezePeriods", Value: newDTOFreezePeriodsFromModel(calendar)},
+		}, firestore.LastUpdateTime(calendar.UpdateTime))
+	}
+	switch status.Code(err) {
+	case codes.OK:
+		return nil
+	case codes.AlreadyExists, codes.FailedPrecondition, codes.NotFound:
+		return ErrConflict
+	default:
+		return fmt.Errorf("failed to update calendar of %s: %w", owner, err)
+	}
+}
+
 func fetchRepoConfigs(ctx context.Context, iter *firestore.DocumentIterator) ([]*model.RepositoryConfig, error) {
 	configs := []*model.RepositoryConfig{}
 	for {
@@ -138,14 +304,56 @@ func repoFrom(snapshot *firestore.DocumentSnapshot) (*model.RepositoryConfig, er
 	if err != nil {
 		return nil, fmt.Errorf("failed to convert DTO to model: %w", err)
 	}
+	m.UpdateTime = snapshot.UpdateTime
 	return m, nil
 }
 
+func ownerCalendarFrom(snapshot *firestore.DocumentSnapshot) (*model.Calendar, error) {
+	if !snapshot.Exists() {
+		return &model.Calendar{}, nil
+	}
+	var dto dtoInstallationTarget
+	if err := snapshot.DataTo(&dto); err != nil {
+		return nil, err
+	}
+	calendar, err := dto.calendarToModel()
+	if err != nil {
+		return nil, err
+	}
+	calendar.UpdateTime = snapshot.UpdateTime
+	return calendar, nil
+}
+
+type dtoInstallationTarget struct {
+	ExceptionDates []*dtoExceptionDate
+	FreezePeriods  []*dtoFreezePeriod
+}
+
+func (d *dtoInstallationTarget) calendarToModel() (*model.Calendar, error) {
+	dates, err := exceptionDatesToModel(d.ExceptionDates)
+	if err != nil {
+		return nil, err
+	}
+	return &model.Calendar{ExceptionDates: dates, FreezePeriods: freezePeriodsToModel(d.FreezePeriods)}, nil
+}
+
 type dtoRepositoryConfig struct {
-	Owner          string
-	Name           string
-	Schedules      *dtoMergeChanceSchedules
-	MergeAvailable bool
+	Owner              string
+	Name               string
+	TimeZone           string
+	Schedules          *dtoMergeChanceSchedules
+	MergeAvailable     bool
+	ExceptionDates     []*dtoExceptionDate
+	FreezePeriods      []*dtoFreezePeriod
+	Override           *dtoOverride
+	BypassLabel        string
+	BaseBranchPatterns []string
+	BranchRules        []*dtoBranchRule
+	StatusContext      string
+	OpenDescription    string
+	ClosedDescription  string
+	QuarantinedAt      time.Time
+	FeedGeneration     int
 }
 
 func (d *dtoRepositoryConfig) ToModel() (*model.RepositoryConfig, error) {
@@ -157,118 +365,238 @@ func (d *dtoRepositoryConfig) ToModel() (*model.RepositoryConfig, error) {
 	m.Schedules = s
 	m.Name = d.Name
 	m.Owner = d.Owner
+	m.TimeZone = d.TimeZone
+	dates, err := exceptionDatesToModel(d.ExceptionDates)
+	if err != nil {
+		return nil, err
+	}
+	m.Calendar = &model.Calendar{ExceptionDates: dates, FreezePeriods: freezePeriodsToModel(d.FreezePeriods)}
 	m.MergeAvailable = d.MergeAvailable
+	m.Override = d.Override.toModel()
+	m.BypassLabel = d.BypassLabel
+	m.BaseBranchPatterns = d.BaseBranchPatterns
+	rules, err := branchRulesToModel(d.BranchRules)
+	if err != nil {
+		return nil, err
+	}
+	m.BranchRules = rules
+	m.StatusContext = d.StatusContext
+	m.OpenDescription = d.OpenDescription
+	m.ClosedDescription = d.ClosedDescription
+	m.QuarantinedAt = d.QuarantinedAt
+	m.FeedGeneration = d.FeedGeneration
 	return m, nil
 }
 
+// newDTOMergeChanceSchedulesFromModel returns the DTO of the schedules. Nil schedules are stored as the schedules without any windows.
 func newDTOMergeChanceSchedulesFromModel(s *model.MergeChanceSchedules) *dtoMergeChanceSchedules {
-	dto := &dtoMergeChanceSchedules{}
-	if s.Sunday != nil {
-		dto.Sunday = &dtoMergeChanceSchedule{
-			StartHour: s.Sunday.StartHour,
-			StopHour:  s.Sunday.StopHour,
-		}
-	}
-	if s.Monday != nil {
-		dto.Monday = &dtoMergeChanceSchedule{
-			StartHour: s.Monday.StartHour,
-			StopHour:  s.Monday.StopHour,
-		}
+	if s == nil {
+		s = &model.MergeChanceSchedules{}
 	}
-	if s.Tuesday != nil {
-		dto.Tuesday = &dtoMergeChanceSchedule{
-			StartHour: s.Tuesday.StartHour,
-			StopHour:  s.Tuesday.StopHour,
-		}
+	return &dtoMergeChanceSchedules{
+		SundayWindows:    newDTOMergeChanceScheduleListFromModel(s.Sunday),
+		MondayWindows:    newDTOMergeChanceScheduleListFromModel(s.Monday),
+		TuesdayWindows:   newDTOMergeChanceScheduleListFromModel(s.Tuesday),
+		WednesdayWindows: newDTOMergeChanceScheduleListFromModel(s.Wednesday),
+		ThursdayWindows:  newDTOMergeChanceScheduleListFromModel(s.Thursday),
+		FridayWindows:    newDTOMergeChanceScheduleListFromModel(s.Friday),
+		SaturdayWindows:  newDTOMergeChanceScheduleListFromModel(s.Saturday),
 	}
-	if s.Wednesday != nil {
-		dto.Wednesday = &dtoMergeChanceSchedule{
-			StartHour: s.Wednesday.StartHour,
-			StopHour:  s.Wednesday.StopHour,
-		}
+}
+
+func newDTOMergeChanceScheduleListFromModel(schedules []*model.MergeChanceSchedule) []*dtoMergeChanceSchedule {
+	dtos := []*dtoMergeChanceSchedule{}
+	for _, s := range schedules {
+		dtos = append(dtos, newDTOMergeChanceScheduleFromModel(s))
 	}
-	if s.Thursday != nil {
-		dto.Thursday = &dtoMergeChanceSchedule{
-			StartHour: s.Thursday.StartHour,
-			StopHour:  s.Thursday.StopHour,
-		}
+	return dtos
+}
+
+func newDTOMergeChanceScheduleFromModel(s *model.MergeChanceSchedule) *dtoMergeChanceSchedule {
+	if s == nil {
+		return nil
 	}
-	if s.Saturday != nil {
-		dto.Saturday = &dtoMergeChanceSchedule{
-			StartHour: s.Saturday.StartHour,
-			StopHour:  s.Saturday.StopHour,
-		}
+	return &dtoMergeChanceSchedule{
+		StartHour:    s.StartHour,
+		StartMinute:  s.StartMinute,
+		StopHour:     s.StopHour,
+		StopMinute:   s.StopMinute,
+		StopsNextDay: s.StopsNextDay,
 	}
-	if s.Friday != nil {
-		dto.Friday = &dtoMergeChanceSchedule{
-			StartHour: s.Friday.StartHour,
-			StopHour:  s.Friday.StopHour,
-		}
-	}
-	return dto
 }
 
+// dtoMergeChanceSchedule is a stored schedule.
+// StartMinute and StopMinute are absent in documents written before minute precision was introduced and read as zero.
+// StopsNextDay is absent in documents written before overnight schedules were introduced and read as false.
 type dtoMergeChanceSchedule struct {
-	StartHour int
-	StopHour  int
+	StartHour    int
+	StartMinute  int
+	StopHour     int
+	StopMinute   int
+	StopsNextDay bool
 }
 
+func (dto *dtoMergeChanceSchedule) toModel() *model.MergeChanceSchedule {
+	if dto == nil {
+		return nil
+	}
+	return &model.MergeChanceSchedule{
+		StartHour:    dto.StartHour,
+		StartMinute:  dto.StartMinute,
+		StopHour:     dto.StopHour,
+		StopMinute:   dto.StopMinute,
+		StopsNextDay: dto.StopsNextDay,
+	}
+}
+
+// dtoMergeChanceSchedules is stored schedules.
+// The fields named after each weekday hold a single schedule and are written by older versions; they are read only if the corresponding *Windows field is absent.
 type dtoMergeChanceSchedules struct {
-	Sunday    *dtoMergeChanceSchedule
-	Monday    *dtoMergeChanceSchedule
-	Tuesday   *dtoMergeChanceSchedule
-	Wednesday *dtoMergeChanceSchedule
-	Thursday  *dtoMergeChanceSchedule
-	Friday    *dtoMergeChanceSchedule
-	Saturday  *dtoMergeChanceSchedule
+	Sunday           *dtoMergeChanceSchedule
+	Monday           *dtoMergeChanceSchedule
+	Tuesday          *dtoMergeChanceSchedule
+	Wednesday        *dtoMergeChanceSchedule
+	Thursday         *dtoMergeChanceSchedule
+	Friday           *dtoMergeChanceSchedule
+	Saturday         *dtoMergeChanceSchedule
+	SundayWindows    []*dtoMergeChanceSchedule
+	MondayWindows    []*dtoMergeChanceSchedule
+	TuesdayWindows   []*dtoMergeChanceSchedule
+	WednesdayWindows []*dtoMergeChanceSchedule
+	ThursdayWindows  []*dtoMergeChanceSchedule
+	FridayWindows    []*dtoMergeChanceSchedule
+	SaturdayWindows  []*dtoMergeChanceSchedule
 }
 
 func (dto *dtoMergeChanceSchedules) toModel() (*model.MergeChanceSchedules, error) {
 	if dto == nil {
 		return &model.MergeChanceSchedules{}, nil
 	}
-	s := &model.MergeChanceSchedules{}
-	if dto.Sunday != nil {
-		s.Sunday = &model.MergeChanceSchedule{
-			StartHour: dto.Sunday.StartHour,
-			StopHour:  dto.Sunday.StopHour,
+	return &model.MergeChanceSchedules{
+		Sunday:    schedulesToModel(dto.SundayWindows, dto.Sunday),
+		Monday:    schedulesToModel(dto.MondayWindows, dto.Monday),
+		Tuesday:   schedulesToModel(dto.TuesdayWindows, dto.Tuesday),
+		Wednesday: schedulesToModel(dto.WednesdayWindows, dto.Wednesday),
+		Thursday:  schedulesToModel(dto.ThursdayWindows, dto.Thursday),
+		Friday:    schedulesToModel(dto.FridayWindows, dto.Friday),
+		Saturday:  schedulesToModel(dto.SaturdayWindows, dto.Saturday),
+	}, nil
+}
+
+func schedulesToModel(windows []*dtoMergeChanceSchedule, legacy *dtoMergeChanceSchedule) []*model.MergeChanceSchedule {
+	if windows == nil {
+		if legacy == nil {
+			return nil
 		}
+		return []*model.MergeChanceSchedule{legacy.toModel()}
 	}
-	if dto.Monday != nil {
-		s.Monday = &model.MergeChanceSchedule{
-			StartHour: dto.Monday.StartHour,
-			StopHour:  dto.Monday.StopHour,
-		}
+	schedules := make([]*model.MergeChanceSchedule, 0, len(windows))
+	for _, w := range windows {
+		schedules = append(schedules, w.toModel())
 	}
-	if dto.Tuesday != nil {
-		s.Tuesday = &model.MergeChanceSchedule{
-			StartHour: dto.Tuesday.StartHour,
-			StopHour:  dto.Tuesday.StopHour,
-		}
+	return schedules
+}
+
+type dtoExceptionDate struct {
+	Date   string
+	Reason string
+}
+
+func newDTOExceptionDatesFromModel(calendar *model.Calendar) []*dtoExceptionDate {
+	dtos := []*dtoExceptionDate{}
+	if calendar == nil {
+		return dtos
 	}
-	if dto.Wednesday != nil {
-		s.Wednesday = &model.MergeChanceSchedule{
-			StartHour: dto.Wednesday.StartHour,
-			StopHour:  dto.Wednesday.StopHour,
-		}
+	for _, d := range calendar.ExceptionDates {
+		dtos = append(dtos, &dtoExceptionDate{Date: d.Date.String(), Reason: d.Reason})
 	}
-	if dto.Thursday != nil {
-		s.Thursday = &model.MergeChanceSchedule{
-			StartHour: dto.Thursday.StartHour,
-			StopHour:  dto.Thursday.StopHour,
+	return dtos
+}
+
+func exceptionDatesToModel(dtos []*dtoExceptionDate) ([]*model.ExceptionDate, error) {
+	dates := []*model.ExceptionDate{}
+	for _, dto := range dtos {
+		date, err := model.ParseDate(dto.Date)
+		if err != nil {
+			return nil, err
 		}
+		dates = append(dates, &model.ExceptionDate{Date: date, Reason: dto.Reason})
 	}
-	if dto.Friday != nil {
-		s.Friday = &model.MergeChanceSchedule{
-			StartHour: dto.Friday.StartHour,
-			StopHour:  dto.Friday.StopHour,
-		}
+	return dates, nil
+}
+
+type dtoFreezePeriod struct {
+	Start   time.Time
+	End     time.Time
+	Summary string
+}
+
+func newDTOFreezePeriodsFromModel(calendar *model.Calendar) []*dtoFreezePeriod {
+	dtos := []*dtoFreezePeriod{}
+	if calendar == nil {
+		return dtos
+	}
+	for _, p := range calendar.FreezePeriods {
+		dtos = append(dtos, &dtoFreezePeriod{Start: p.Start, End: p.End, Summary: p.Summary})
+	}
+	return dtos
+}
+
+func freezePeriodsToModel(dtos []*dtoFreezePeriod) []*model.FreezePeriod {
+	periods := []*model.FreezePeriod{}
+	for _, dto := range dtos {
+		periods = append(periods, &model.FreezePeriod{Start: dto.Start, End: dto.End, Summary: dto.Summary})
+	}
+	return periods
+}
+
+type dtoOverride struct {
+	MergeAvailable bool
+	Until          time.Time
+	CreatedBy      string
+	CreatedAt      time.Time
+}
+
+func newDTOOverrideFromModel(o *model.Override) *dtoOverride {
+	if o == nil {
+		return nil
 	}
-	if dto.Saturday != nil {
-		s.Saturday = &model.MergeChanceSchedule{
-			StartHour: dto.Saturday.StartHour,
-			StopHour:  dto.Saturday.StopHour,
+	return &dtoOverride{MergeAvailable: o.MergeAvailable, Until: o.Until, CreatedBy: o.CreatedBy, CreatedAt: o.CreatedAt}
+}
+
+func (d *dtoOverride) toModel() *model.Override {
+	if d == nil {
+		return nil
+	}
+	return &model.Override{MergeAvailable: d.MergeAvailable, Until: d.Until, CreatedBy: d.CreatedBy, CreatedAt: d.CreatedAt}
+}
+
+type dtoBranchRule struct {
+	Pattern        string
+	Schedules      *dtoMergeChanceSchedules
+	MergeAvailable bool
+}
+
+func newDTOBranchRulesFromModel(rules []*model.BranchRule) []*dtoBranchRule {
+	dtos := []*dtoBranchRule{}
+	for _, rule := range rules {
+		dtos = append(dtos, &dtoBranchRule{
+			Pattern:        rule.Pattern,
+			Schedules:      newDTOMergeChanceSchedulesFromModel(rule.Schedules),
+			MergeAvailable: rule.MergeAvailable,
+		})
+	}
+	return dtos
+}
+
+func branchRulesToModel(dtos []*dtoBranchRule) ([]*model.BranchRule, error) {
+	rules := []*model.BranchRule{}
+	for _, dto := range dtos {
+		schedules, err := dto.Schedules.toModel()
+		if err != nil {
+			return nil, fmt.Errorf("branch rule %q: %w", dto.Pattern, err)
 		}
+		rules = append(rules, &model.BranchRule{Pattern: dto.Pattern, Schedules: schedules, MergeAvailable: dto.MergeAvailable})
 	}
-	return s, nil
+	return rules, nil
 }
@@ -34,6 +34,20 @@ func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
 	return m.recorder
 }
 
+// AddAuditEvents mocks base method
+func (m *MockRepository) AddAuditEvents(arg0 context.Context, arg1 []*model.AuditEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddAuditEvents", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddAuditEvents indicates an expected call of AddAuditEvents
+func (mr *MockRepositoryMockRecorder) AddAuditEvents(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddAuditEvents", reflect.TypeOf((*MockRepository)(nil).AddAuditEvents), arg0, arg1)
+}
+
 // DeleteRepositoryConfig mocks base method
 func (m *MockRepository) DeleteRepositoryConfig(arg0 context.Context, arg1, arg2 string) error {
 	m.ctrl.T.Helper()
@@ -62,6 +76,21 @@ func (mr *MockRepositoryMockRecorder) DeleteRepositoryConfigsByOwner(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRepositoryConfigsByOwner", reflect.TypeOf((*MockRepository)(nil).DeleteRepositoryConfigsByOwner), arg0, arg1)
 }
 
+// GetOwnerCalendar mocks base method
+func (m *MockRepository) GetOwnerCalendar(arg0 context.Context, arg1 string) (*model.Calendar, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOwnerCalendar", arg0, arg1)
+	ret0, _ := ret[0].(*model.Calendar)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOwnerCalendar indicates an expected call of GetOwnerCalendar
+func (mr *MockRepositoryMockRecorder) GetOwnerCalendar(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOwnerCalendar", reflect.TypeOf((*MockRepository)(nil).GetOwnerCalendar), arg0, arg1)
+}
+
 // GetRepositoryConfig mocks base method
 func (m *MockRepository) GetRepositoryConfig(arg0 context.Context, arg1, arg2 string) (*model.RepositoryConfig, error) {
 	m.ctrl.T.Helper()
@@ -77,6 +106,22 @@ func (mr *MockRepositoryMockRecorder) GetRepositoryConfig(arg0, arg1, arg2 inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRepositoryConfig", reflect.TypeOf((*MockRepository)(nil).GetRepositoryConfig), arg0, arg1, arg2)
 }
 
+// ListAuditEvents mocks base method
+func (m *MockRepository) ListAuditEvents(arg0 context.Context, arg1, arg2 string, arg3 int, arg4 string) ([]*model.AuditEvent, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAuditEvents", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].([]*model.AuditEvent)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListAuditEvents indicates an expected call of ListAuditEvents
+func (mr *MockRepositoryMockRecorder) ListAuditEvents(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAuditEvents", reflect.TypeOf((*MockRepository)(nil).ListAuditEvents), arg0, arg1, arg2, arg3, arg4)
+}
+
 // ListConfigsByOwners mocks base method
 func (m *MockRepository) ListConfigsByOwners(arg0 context.Context) (map[string][]*model.RepositoryConfig, error) {
 	m.ctrl.T.Helper()
@@ -105,3 +150,45 @@ func (mr *MockRepositoryMockRecorder) PutRepositoryConfigs(arg0, arg1 interface{
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutRepositoryConfigs", reflect.TypeOf((*MockRepository)(nil).PutRepositoryConfigs), arg0, arg1)
 }
+
+// UpdateMergeChanceStates mocks base method
+func (m *MockRepository) UpdateMergeChanceStates(arg0 context.Context, arg1 *model.RepositoryConfig) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateMergeChanceStates", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateMergeChanceStates indicates an expected call of UpdateMergeChanceStates
+func (mr *MockRepositoryMockRecorder) UpdateMergeChanceStates(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMergeChanceStates", reflect.TypeOf((*MockRepository)(nil).UpdateMergeChanceStates), arg0, arg1)
+}
+
+// UpdateOwnerCalendar mocks base method
+func (m *MockRepository) UpdateOwnerCalendar(arg0 context.Context, arg1 string, arg2 *model.Calendar) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateOwnerCalendar", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateOwnerCalendar indicates an expected call of UpdateOwnerCalendar
+func (mr *MockRepositoryMockRecorder) UpdateOwnerCalendar(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOwnerCalendar", reflect.TypeOf((*MockRepository)(nil).UpdateOwnerCalendar), arg0, arg1, arg2)
+}
+
+// UpdateRepositoryConfig mocks base method
+func (m *MockRepository) UpdateRepositoryConfig(arg0 context.Context, arg1 *model.RepositoryConfig) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRepositoryConfig", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateRepositoryConfig indicates an expected call of UpdateRepositoryConfig
+func (mr *MockRepositoryMockRecorder) UpdateRepositoryConfig(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRepositoryConfig", reflect.TypeOf((*MockRepository)(nil).UpdateRepositoryConfig), arg0, arg1)
+}
@@ -1 +1,216 @@
 package repo
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/aereal/merge-chance-time/domain/model"
+)
+
+func Test_dtoMergeChanceSchedules_toModel(t *testing.T) {
+	tests := []struct {
+		name string
+		dto  *dtoMergeChanceSchedules
+		want *model.MergeChanceSchedules
+	}{
+		{
+			name: "hour only",
+			dto: &dtoMergeChanceSchedules{
+				Monday: &dtoMergeChanceSchedule{StartHour: 10, StopHour: 18},
+			},
+			want: &model.MergeChanceSchedules{
+				Monday: []*model.MergeChanceSchedule{{StartHour: 10, StartMinute: 0, StopHour: 18, StopMinute: 0}},
+			},
+		},
+		{
+			name: "with minutes",
+			dto: &dtoMergeChanceSchedules{
+				Monday: &dtoMergeChanceSchedule{StartHour: 9, StartMinute: 30, StopHour: 17, StopMinute: 45},
+			},
+			want: &model.MergeChanceSchedules{
+				Monday: []*model.MergeChanceSchedule{{StartHour: 9, StartMinute: 30, StopHour: 17, StopMinute: 45}},
+			},
+		},
+		{
+			name: "windows",
+			dto: &dtoMergeChanceSchedules{
+				Monday: &dtoMergeChanceSchedule{StartHour: 0, StopHour: 23},
+				MondayWindows: []*dtoMergeChanceSchedule{
+					{StartHour: 10, StopHour: 12},
+					{StartHour: 13, StopHour: 18},
+				},
+				TuesdayWindows: []*dtoMergeChanceSchedule{},
+			},
+			want: &model.MergeChanceSchedules{
+				Monday: []*model.MergeChanceSchedule{
+					{StartHour: 10, StopHour: 12},
+					{StartHour: 13, StopHour: 18},
+				},
+				Tuesday: []*model.MergeChanceSchedule{},
+			},
+		},
+		{
+			name: "overnight",
+			dto: &dtoMergeChanceSchedules{
+				SaturdayWindows: []*dtoMergeChanceSchedule{{StartHour: 22, StopHour: 2, StopsNextDay: true}},
+			},
+			want: &model.MergeChanceSchedules{
+				Saturday: []*model.MergeChanceSchedule{{StartHour: 22, StopHour: 2, StopsNextDay: true}},
+			},
+		},
+		{
+			name: "nil",
+			dto:  nil,
+			want: &model.MergeChanceSchedules{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.dto.toModel()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("dtoMergeChanceSchedules.toModel() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_newDTOMergeChanceSchedulesFromModel(t *testing.T) {
+	tests := []struct {
+		name      string
+		schedules *model.MergeChanceSchedules
+		want      *model.MergeChanceSchedules
+	}{
+		{
+			name:      "windows",
+			schedules: &model.MergeChanceSchedules{Monday: []*model.MergeChanceSchedule{{StartHour: 10, StopHour: 12}, {StartHour: 13, StopHour: 18}}},
+			want: &model.MergeChanceSchedules{
+				Sunday:    []*model.MergeChanceSchedule{},
+				Monday:    []*model.MergeChanceSchedule{{StartHour: 10, StopHour: 12}, {StartHour: 13, StopHour: 18}},
+				Tuesday:   []*model.MergeChanceSchedule{},
+				Wednesday: []*model.MergeChanceSchedule{},
+				Thursday:  []*model.MergeChanceSchedule{},
+				Friday:    []*model.MergeChanceSchedule{},
+				Saturday:  []*model.MergeChanceSchedule{},
+			},
+		},
+		{
+			name:      "nil",
+			schedules: nil,
+			want: &model.MergeChanceSchedules{
+				Sunday:    []*model.MergeChanceSchedule{},
+				Monday:    []*model.MergeChanceSchedule{},
+				Tuesday:   []*model.MergeChanceSchedule{},
+				Wednesday: []*model.MergeChanceSchedule{},
+				Thursday:  []*model.MergeChanceSchedule{},
+				Friday:    []*model.MergeChanceSchedule{},
+				Saturday:  []*model.MergeChanceSchedule{},
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := newDTOMergeChanceSchedulesFromModel(tt.schedules).toModel()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("newDTOMergeChanceSchedulesFromModel().toModel() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_chunkDTOs(t *testing.T) {
+	perBatch := maxBatchWrites / writesPerConfig
+	tests := []struct {
+		name string
+		n    int
+		want []int
+	}{
+		{name: "empty", n: 0, want: []int{}},
+		{name: "single", n: 1, want: []int{1}},
+		{name: "full batch", n: perBatch, want: []int{perBatch}},
+		{name: "over a batch", n: perBatch + 1, want: []int{perBatch, 1}},
+		{name: "several batches", n: perBatch*2 + 3, want: []int{perBatch, perBatch, 3}},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			dtos := make([]*dtoRepositoryConfig, tt.n)
+			for i := range dtos {
+				dtos[i] = &dtoRepositoryConfig{Owner: "aereal", Name: fmt.Sprintf("repo-%d", i)}
+			}
+			chunks := chunkDTOs(dtos, perBatch)
+			got := []int{}
+			var seen []*dtoRepositoryConfig
+			for _, chunk := range chunks {
+				if len(chunk)*writesPerConfig > maxBatchWrites {
+					t.Errorf("chunk of %d configs exceeds %d writes", len(chunk), maxBatchWrites)
+				}
+				got = append(got, len(chunk))
+				seen = append(seen, chunk...)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("chunkDTOs() sizes = %v, want %v", got, tt.want)
+			}
+			if len(seen) != len(dtos) {
+				t.Fatalf("chunkDTOs() has %d configs, want %d", len(seen), len(dtos))
+			}
+			for i := range seen {
+				if seen[i] != dtos[i] {
+					t.Errorf("chunkDTOs()[%d] = %s, want %s", i, seen[i].Name, dtos[i].Name)
+				}
+			}
+		})
+	}
+}
+
+func Test_mergeChanceStateUpdates(t *testing.T) {
+	until := time.Date(2020, time.February, 3, 18, 0, 0, 0, time.UTC)
+	config := &model.RepositoryConfig{
+		Owner:          "aereal",
+		Name:           "example-repo",
+		TimeZone:       "Asia/Tokyo",
+		Schedules:      &model.MergeChanceSchedules{Monday: []*model.MergeChanceSchedule{{StartHour: 10, StopHour: 18}}},
+		MergeAvailable: true,
+		BranchRules:    []*model.BranchRule{{Pattern: "release/*", Schedules: &model.MergeChanceSchedules{}, MergeAvailable: false}},
+		Override:       &model.Override{MergeAvailable: true, Until: until, CreatedBy: "aereal"},
+		BypassLabel:    "emergency",
+	}
+	got := map[string]interface{}{}
+	for _, u := range mergeChanceStateUpdates(config) {
+		got[u.Path] = u.Value
+	}
+	want := map[string]interface{}{
+		"MergeAvailable": true,
+		"BranchRules":    newDTOBranchRulesFromModel(config.BranchRules),
+		"Override":       &dtoOverride{MergeAvailable: true, Until: until, CreatedBy: "aereal"},
+		"QuarantinedAt":  time.Time{},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("mergeChanceStateUpdates() = %#v, want %#v", got, want)
+	}
+}
+
+func Test_dtoAuditEvent_toModel(t *testing.T) {
+	event := &model.AuditEvent{
+		Owner:         "aereal",
+		Name:          "example-repo",
+		Type:          model.AuditEventMergeClosed,
+		BranchPattern: "release/*",
+		Description:   "",
+		CreatedAt:     time.Date(2020, time.February, 3, 18, 0, 0, 0, time.UTC),
+	}
+	got := newDTOAuditEventFromModel(event).toModel("aereal", "example-repo", "0xdeadbeaf")
+	want := *event
+	want.ID = "0xdeadbeaf"
+	if !reflect.DeepEqual(got, &want) {
+		t.Errorf("toModel() = %#v, want %#v", got, &want)
+	}
+}
@@ -3,45 +3,105 @@ package service
 import (
 	"context"
 	"fmt"
+	"net/url"
+	"time"
 
 	"github.com/aereal/merge-chance-time/app/adapter/githubapi"
 	"github.com/google/go-github/v30/github"
 )
 
-var (
-	ctxName = "merge-chance-time"
+const (
+	// RequestOverrideActionID is the identifier of the check run action requesting an override of the merge chance.
+	RequestOverrideActionID = "request_override"
+
+	transitionTimeLayout    = "Mon 15:04 MST"
+	farTransitionTimeLayout = "Mon Jan 2 15:04 MST"
+	farTransitionGap        = 6 * 24 * time.Hour
+)
+
+// Reporter is the way the merge chance states of pull requests are reported to GitHub.
+type Reporter int
+
+const (
+	// ReportCheckRun reports the states as check runs. It requires the checks:write permission of the app.
+	ReportCheckRun Reporter = iota
+	// ReportCommitStatus reports the states as legacy commit statuses. It requires the statuses:write permission of the app.
+	ReportCommitStatus
 )
 
-func New() (Service, error) {
-	return &serviceImpl{}, nil
+// New returns the service reporting with the reporter.
+// adminOrigin is the origin of the admin front-end linked from the reports. Reports have no links if it is nil.
+func New(reporter Reporter, adminOrigin *url.URL) (Service, error) {
+	switch reporter {
+	case ReportCheckRun:
+		return &checkRunService{adminOrigin: adminOrigin}, nil
+	case ReportCommitStatus:
+		return &serviceImpl{adminOrigin: adminOrigin}, nil
+	default:
+		return nil, fmt.Errorf("unknown reporter: %d", reporter)
+	}
+}
+
+// Report is the merge chance state of a pull request to be reported.
+type Report struct {
+	// Context is the name of the status context or the check run.
+	Context string
+	// Description is a short description of the state (e.g. "closed").
+	Description string
+	// NextTransition is the instant the state changes next, or the zero time if it is not scheduled.
+	NextTransition time.Time
+	// Now is the instant the state is evaluated at. The next transition is described relative to it.
+	Now time.Time
+}
+
+// describe returns the description followed by the next transition (e.g. "closed until Mon 10:00 JST").
+func (r *Report) describe() string {
+	if r.NextTransition.IsZero() {
+		return r.Description
+	}
+	return fmt.Sprintf("%s until %s", r.Description, formatTransition(r.NextTransition, r.Now))
 }
 
+// Service reports the merge chance states of pull requests.
 type Service interface {
-	ApprovePullRequest(ctx context.Context, client githubapi.Client, pr *github.PullRequest) error
-	PendingPullRequest(ctx context.Context, client githubapi.Client, pr *github.PullRequest) error
+	ApprovePullRequest(ctx context.Context, client githubapi.Client, pr *github.PullRequest, report *Report) error
+	PendingPullRequest(ctx context.Context, client githubapi.Client, pr *github.PullRequest, report *Report) error
+	// RetirePullRequest makes the status of statusContext passing, so that it no longer blocks the pull request after the context is renamed to successor.
+	RetirePullRequest(ctx context.Context, client githubapi.Client, pr *github.PullRequest, statusContext, successor string) error
+	AcknowledgeOverrideRequest(ctx context.Context, client githubapi.Client, repo *github.Repository, checkRun *github.CheckRun, requester string) error
 }
 
-type serviceImpl struct{}
+type serviceImpl struct {
+	adminOrigin *url.URL
+}
 
-func (s *serviceImpl) ApprovePullRequest(ctx context.Context, client githubapi.Client, pr *github.PullRequest) error {
-	return s.createCommitStatus(ctx, client, pr, "success")
+func (s *serviceImpl) ApprovePullRequest(ctx context.Context, client githubapi.Client, pr *github.PullRequest, report *Report) error {
+	return s.createCommitStatus(ctx, client, pr, report.Context, "success", report.describe())
 }
 
-func (s *serviceImpl) PendingPullRequest(ctx context.Context, client githubapi.Client, pr *github.PullRequest) error {
-	return s.createCommitStatus(ctx, client, pr, "pending")
+func (s *serviceImpl) PendingPullRequest(ctx context.Context, client githubapi.Client, pr *github.PullRequest, report *Report) error {
+	return s.createCommitStatus(ctx, client, pr, report.Context, "pending", report.describe())
 }
 
-func (s *serviceImpl) createCommitStatus(ctx context.Context, client githubapi.Client, pr *github.PullRequest, state string) error {
+func (s *serviceImpl) RetirePullRequest(ctx context.Context, client githubapi.Client, pr *github.PullRequest, statusContext, successor string) error {
+	return s.createCommitStatus(ctx, client, pr, statusContext, "success", fmt.Sprintf("moved to %s", successor))
+}
+
+// AcknowledgeOverrideRequest does nothing because commit statuses have no actions.
+func (s *serviceImpl) AcknowledgeOverrideRequest(ctx context.Context, client githubapi.Client, repo *github.Repository, checkRun *github.CheckRun, requester string) error {
+	return nil
+}
+
+// createCommitStatus creates the status on the head commit in the base repository, which is the repository the app is installed on
+// even if the pull request comes from a fork.
+func (s *serviceImpl) createCommitStatus(ctx context.Context, client githubapi.Client, pr *github.PullRequest, statusContext, state, desc string) error {
 	head := pr.GetHead()
-	repo := head.GetRepo()
-	desc := fmt.Sprintf("%s is open", ctxName)
-	if state != "success" {
-		desc = fmt.Sprintf("%s is pending", ctxName)
-	}
+	repo := pr.GetBase().GetRepo()
 	status := &github.RepoStatus{
 		State:       &state,
-		Context:     &ctxName,
+		Context:     &statusContext,
 		Description: &desc,
+		TargetURL:   repositoryPageURL(s.adminOrigin, repo),
 	}
 	_, _, err := client.Repositories().CreateStatus(ctx, repo.GetOwner().GetLogin(), repo.GetName(), head.GetSHA(), status)
 	if err != nil {
@@ -49,3 +109,119 @@ func (s *serviceImpl) createCommitStatus(ctx context.Context, client githubapi.C
 	}
 	return nil
 }
+
+// checkRunService reports the states as check runs.
+// A new check run is created on every report, so that GitHub shows the latest one and its actions only.
+type checkRunService struct {
+	adminOrigin *url.URL
+}
+
+func (s *checkRunService) ApprovePullRequest(ctx context.Context, client githubapi.Client, pr *github.PullRequest, report *Report) error {
+	summary := "No closed window is scheduled."
+	if !report.NextTransition.IsZero() {
+		summary = fmt.Sprintf("Merges are open until %s.", formatTransition(report.NextTransition, report.Now))
+	}
+	now := github.Timestamp{Time: time.Now()}
+	return s.createCheckRun(ctx, client, pr, github.CreateCheckRunOptions{
+		Name:        report.Context,
+		Status:      github.String("completed"),
+		Conclusion:  github.String("success"),
+		CompletedAt: &now,
+		Output: &github.CheckRunOutput{
+			Title:   github.String(report.describe()),
+			Summary: &summary,
+		},
+	})
+}
+
+func (s *checkRunService) PendingPullRequest(ctx context.Context, client githubapi.Client, pr *github.PullRequest, report *Report) error {
+	summary := "No merge window is scheduled."
+	if !report.NextTransition.IsZero() {
+		summary = fmt.Sprintf("The next merge window opens at %s.", formatTransition(report.NextTransition, report.Now))
+	}
+	now := github.Timestamp{Time: time.Now()}
+	return s.createCheckRun(ctx, client, pr, github.CreateCheckRunOptions{
+		Name:      report.Context,
+		Status:    github.String("in_progress"),
+		StartedAt: &now,
+		Output: &github.CheckRunOutput{
+			Title:   github.String(report.describe()),
+			Summary: &summary,
+		},
+		Actions: []*github.CheckRunAction{
+			{
+				Label:       "Request override",
+				Description: "Ask admins to open merges now",
+				Identifier:  RequestOverrideActionID,
+			},
+		},
+	})
+}
+
+// RetirePullRequest completes the check run of statusContext as neutral, which does not block merges.
+func (s *checkRunService) RetirePullRequest(ctx context.Context, client githubapi.Client, pr *github.PullRequest, statusContext, successor string) error {
+	now := github.Timestamp{Time: time.Now()}
+	return s.createCheckRun(ctx, client, pr, github.CreateCheckRunOptions{
+		Name:        statusContext,
+		Status:      github.String("completed"),
+		Conclusion:  github.String("neutral"),
+		CompletedAt: &now,
+		Output: &github.CheckRunOutput{
+			Title:   github.String(fmt.Sprintf("moved to %s", successor)),
+			Summary: github.String(fmt.Sprintf("The merge chance is reported as %s.", successor)),
+		},
+	})
+}
+
+// AcknowledgeOverrideRequest appends the request to the check run so that admins can see it on the pull request.
+func (s *checkRunService) AcknowledgeOverrideRequest(ctx context.Context, client githubapi.Client, repo *github.Repository, checkRun *github.CheckRun, requester string) error {
+	output := checkRun.GetOutput()
+	text := fmt.Sprintf("@%s requested an override of the merge chance.", requester)
+	if prev := output.GetText(); prev != "" {
+		text = prev + "\n\n" + text
+	}
+	opts := github.UpdateCheckRunOptions{
+		Name: checkRun.GetName(),
+		Output: &github.CheckRunOutput{
+			Title:   output.Title,
+			Summary: output.Summary,
+			Text:    &text,
+		},
+	}
+	_, _, err := client.Checks().UpdateCheckRun(ctx, repo.GetOwner().GetLogin(), repo.GetName(), checkRun.GetID(), opts)
+	if err != nil {
+		return fmt.Errorf("failed to update check run %d on %s: %w", checkRun.GetID(), repo.GetFullName(), err)
+	}
+	return nil
+}
+
+// createCheckRun creates the check run on the head commit in the base repository like createCommitStatus.
+func (s *checkRunService) createCheckRun(ctx context.Context, client githubapi.Client, pr *github.PullRequest, opts github.CreateCheckRunOptions) error {
+	head := pr.GetHead()
+	repo := pr.GetBase().GetRepo()
+	opts.HeadSHA = head.GetSHA()
+	opts.DetailsURL = repositoryPageURL(s.adminOrigin, repo)
+	_, _, err := client.Checks().CreateCheckRun(ctx, repo.GetOwner().GetLogin(), repo.GetName(), opts)
+	if err != nil {
+		return fmt.Errorf("failed to create check run on %s#%d: %w", repo.GetFullName(), pr.GetNumber(), err)
+	}
+	return nil
+}
+
+// formatTransition formats the instant of a transition in its location. The date is omitted if the transition is within a week from now.
+func formatTransition(t, now time.Time) string {
+	if t.Sub(now) < farTransitionGap {
+		return t.Format(transitionTimeLayout)
+	}
+	return t.Format(farTransitionTimeLayout)
+}
+
+// repositoryPageURL returns the URL of the page of the repository on the admin front-end, or nil if adminOrigin is nil.
+func repositoryPageURL(adminOrigin *url.URL, repo *github.Repository) *string {
+	if adminOrigin == nil {
+		return nil
+	}
+	u := *adminOrigin
+	u.Path = fmt.Sprintf("/repos/%s/%s", repo.GetOwner().GetLogin(), repo.GetName())
+	return github.String(u.String())
+}
@@ -0,0 +1,356 @@
+package service
+
+import (
+	"context"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/aereal/merge-chance-time/app/adapter/githubapi"
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-github/v30/github"
+)
+
+var (
+	adminOrigin = &url.URL{Scheme: "https", Host: "mergechancetime.app"}
+	now         = time.Date(2020, time.February, 5, 12, 0, 0, 0, time.UTC) // Wednesday
+	// pr comes from a fork, and the merge chance is reported on the base repository.
+	pr = &github.PullRequest{
+		Number: github.Int(1),
+		Base: &github.PullRequestBranch{
+			Ref: github.String("main"),
+			Repo: &github.Repository{
+				Name:     github.String("example-repo"),
+				FullName: github.String("aereal/example-repo"),
+				Owner:    &github.User{Login: github.String("aereal")},
+			},
+		},
+		Head: &github.PullRequestBranch{
+			SHA: github.String("0xdeadbeaf"),
+			Repo: &github.Repository{
+				Name:     github.String("example-repo"),
+				FullName: github.String("forker/example-repo"),
+				Owner:    &github.User{Login: github.String("forker")},
+			},
+		},
+	}
+)
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		name     string
+		reporter Reporter
+		want     Service
+		wantErr  bool
+	}{
+		{name: "check run", reporter: ReportCheckRun, want: &checkRunService{adminOrigin: adminOrigin}},
+		{name: "commit status", reporter: ReportCommitStatus, want: &serviceImpl{adminOrigin: adminOrigin}},
+		{name: "unknown", reporter: Reporter(-1), wantErr: true},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := New(tt.reporter, adminOrigin)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("New() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			switch want := tt.want.(type) {
+			case *checkRunService:
+				if got, ok := got.(*checkRunService); !ok || got.adminOrigin != want.adminOrigin {
+					t.Errorf("New() = %#v, want %#v", got, want)
+				}
+			case *serviceImpl:
+				if got, ok := got.(*serviceImpl); !ok || got.adminOrigin != want.adminOrigin {
+					t.Errorf("New() = %#v, want %#v", got, want)
+				}
+			}
+		})
+	}
+}
+
+func Test_formatTransition(t *testing.T) {
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name string
+		t    time.Time
+		want string
+	}{
+		{name: "within a day", t: time.Date(2020, time.February, 5, 18, 0, 0, 0, time.UTC), want: "Wed 18:00 UTC"},
+		{name: "in its location", t: time.Date(2020, time.February, 6, 10, 0, 0, 0, tokyo), want: "Thu 10:00 JST"},
+		{name: "within a week", t: time.Date(2020, time.February, 11, 11, 59, 0, 0, time.UTC), want: "Tue 11:59 UTC"},
+		{name: "6 days later", t: time.Date(2020, time.February, 11, 12, 0, 0, 0, time.UTC), want: "Tue Feb 11 12:00 UTC"},
+		{name: "far", t: time.Date(2020, time.March, 2, 10, 0, 0, 0, time.UTC), want: "Mon Mar 2 10:00 UTC"},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			if got := formatTransition(tt.t, now); got != tt.want {
+				t.Errorf("formatTransition() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReport_describe(t *testing.T) {
+	tests := []struct {
+		name   string
+		report *Report
+		want   string
+	}{
+		{name: "no transition", report: &Report{Description: "closed", Now: now}, want: "closed"},
+		{
+			name:   "with transition",
+			report: &Report{Description: "closed", NextTransition: time.Date(2020, time.February, 6, 10, 0, 0, 0, time.UTC), Now: now},
+			want:   "closed until Thu 10:00 UTC",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.report.describe(); got != tt.want {
+				t.Errorf("describe() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_serviceImpl(t *testing.T) {
+	next := time.Date(2020, time.February, 6, 10, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name            string
+		report          func(ctx context.Context, srv Service, client githubapi.Client) error
+		wantContext     string
+		wantState       string
+		wantDescription string
+	}{
+		{
+			name: "approve",
+			report: func(ctx context.Context, srv Service, client githubapi.Client) error {
+				return srv.ApprovePullRequest(ctx, client, pr, &Report{Context: "merge-chance-time", Description: "open", NextTransition: next, Now: now})
+			},
+			wantContext:     "merge-chance-time",
+			wantState:       "success",
+			wantDescription: "open until Thu 10:00 UTC",
+		},
+		{
+			name: "pending",
+			report: func(ctx context.Context, srv Service, client githubapi.Client) error {
+				return srv.PendingPullRequest(ctx, client, pr, &Report{Context: "merge-chance-time", Description: "closed", Now: now})
+			},
+			wantContext:     "merge-chance-time",
+			wantState:       "pending",
+			wantDescription: "closed",
+		},
+		{
+			name: "retire",
+			report: func(ctx context.Context, srv Service, client githubapi.Client) error {
+				return srv.RetirePullRequest(ctx, client, pr, "merge-chance-time", "release-freeze")
+			},
+			wantContext:     "merge-chance-time",
+			wantState:       "success",
+			wantDescription: "moved to release-freeze",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			var got *github.RepoStatus
+			repos := githubapi.NewMockRepositoriesService(ctrl)
+			repos.EXPECT().
+				CreateStatus(gomock.Any(), "aereal", "example-repo", "0xdeadbeaf", gomock.Any()).
+				DoAndReturn(func(_ context.Context, _, _, _ string, status *github.RepoStatus) (*github.RepoStatus, *github.Response, error) {
+					got = status
+					return status, nil, nil
+				}).
+				Times(1)
+			client := githubapi.NewMockClient(ctrl)
+			client.EXPECT().Repositories().Return(repos)
+
+			if err := tt.report(context.Background(), &serviceImpl{adminOrigin: adminOrigin}, client); err != nil {
+				t.Fatal(err)
+			}
+			if got.GetContext() != tt.wantContext || got.GetState() != tt.wantState || got.GetDescription() != tt.wantDescription {
+				t.Errorf("status = context=%s state=%s description=%q, want context=%s state=%s description=%q",
+					got.GetContext(), got.GetState(), got.GetDescription(), tt.wantContext, tt.wantState, tt.wantDescription)
+			}
+			if want := "https://mergechancetime.app/repos/aereal/example-repo"; got.GetTargetURL() != want {
+				t.Errorf("TargetURL = %q, want %q", got.GetTargetURL(), want)
+			}
+		})
+	}
+}
+
+func Test_checkRunService(t *testing.T) {
+	next := time.Date(2020, time.February, 6, 10, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name           string
+		report         func(ctx context.Context, srv Service, client githubapi.Client) error
+		wantName       string
+		wantStatus     string
+		wantConclusion string
+		wantTitle      string
+		wantSummary    string
+		wantAction     string
+	}{
+		{
+			name: "approve",
+			report: func(ctx context.Context, srv Service, client githubapi.Client) error {
+				return srv.ApprovePullRequest(ctx, client, pr, &Report{Context: "merge-chance-time", Description: "open", NextTransition: next, Now: now})
+			},
+			wantName:       "merge-chance-time",
+			wantStatus:     "completed",
+			wantConclusion: "success",
+			wantTitle:      "open until Thu 10:00 UTC",
+			wantSummary:    "Merges are open until Thu 10:00 UTC.",
+		},
+		{
+			name: "approve without transition",
+			report: func(ctx context.Context, srv Service, client githubapi.Client) error {
+				return srv.ApprovePullRequest(ctx, client, pr, &Report{Context: "merge-chance-time", Description: "open", Now: now})
+			},
+			wantName:       "merge-chance-time",
+			wantStatus:     "completed",
+			wantConclusion: "success",
+			wantTitle:      "open",
+			wantSummary:    "No closed window is scheduled.",
+		},
+		{
+			name: "pending",
+			report: func(ctx context.Context, srv Service, client githubapi.Client) error {
+				return srv.PendingPullRequest(ctx, client, pr, &Report{Context: "merge-chance-time", Description: "closed", NextTransition: next, Now: now})
+			},
+			wantName:    "merge-chance-time",
+			wantStatus:  "in_progress",
+			wantTitle:   "closed until Thu 10:00 UTC",
+			wantSummary: "The next merge window opens at Thu 10:00 UTC.",
+			wantAction:  RequestOverrideActionID,
+		},
+		{
+			name: "pending without transition",
+			report: func(ctx context.Context, srv Service, client githubapi.Client) error {
+				return srv.PendingPullRequest(ctx, client, pr, &Report{Context: "merge-chance-time", Description: "closed", Now: now})
+			},
+			wantName:    "merge-chance-time",
+			wantStatus:  "in_progress",
+			wantTitle:   "closed",
+			wantSummary: "No merge window is scheduled.",
+			wantAction:  RequestOverrideActionID,
+		},
+		{
+			name: "retire",
+			report: func(ctx context.Context, srv Service, client githubapi.Client) error {
+				return srv.RetirePullRequest(ctx, client, pr, "merge-chance-time", "release-freeze")
+			},
+			wantName:       "merge-chance-time",
+			wantStatus:     "completed",
+			wantConclusion: "neutral",
+			wantTitle:      "moved to release-freeze",
+			wantSummary:    "The merge chance is reported as release-freeze.",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			var got github.CreateCheckRunOptions
+			checks := githubapi.NewMockChecksService(ctrl)
+			checks.EXPECT().
+				CreateCheckRun(gomock.Any(), "aereal", "example-repo", gomock.Any()).
+				DoAndReturn(func(_ context.Context, _, _ string, opts github.CreateCheckRunOptions) (*github.CheckRun, *github.Response, error) {
+					got = opts
+					return &github.CheckRun{}, nil, nil
+				}).
+				Times(1)
+			client := githubapi.NewMockClient(ctrl)
+			client.EXPECT().Checks().Return(checks)
+
+			if err := tt.report(context.Background(), &checkRunService{adminOrigin: adminOrigin}, client); err != nil {
+				t.Fatal(err)
+			}
+			if got.Name != tt.wantName || got.HeadSHA != "0xdeadbeaf" || got.GetStatus() != tt.wantStatus || got.GetConclusion() != tt.wantConclusion {
+				t.Errorf("check run = name=%s head=%s status=%s conclusion=%s, want name=%s head=0xdeadbeaf status=%s conclusion=%s",
+					got.Name, got.HeadSHA, got.GetStatus(), got.GetConclusion(), tt.wantName, tt.wantStatus, tt.wantConclusion)
+			}
+			if got.Output.GetTitle() != tt.wantTitle || got.Output.GetSummary() != tt.wantSummary {
+				t.Errorf("output = title=%q summary=%q, want title=%q summary=%q", got.Output.GetTitle(), got.Output.GetSummary(), tt.wantTitle, tt.wantSummary)
+			}
+			if want := "https://mergechancetime.app/repos/aereal/example-repo"; got.GetDetailsURL() != want {
+				t.Errorf("DetailsURL = %q, want %q", got.GetDetailsURL(), want)
+			}
+			action := ""
+			if len(got.Actions) > 0 {
+				action = got.Actions[0].Identifier
+			}
+			if len(got.Actions) > 1 || action != tt.wantAction {
+				t.Errorf("Actions = %v, want %q", got.Actions, tt.wantAction)
+			}
+		})
+	}
+}
+
+func Test_checkRunService_AcknowledgeOverrideRequest(t *testing.T) {
+	tests := []struct {
+		name     string
+		prevText string
+		wantText string
+	}{
+		{
+			name:     "first request",
+			wantText: "@aereal requested an override of the merge chance.",
+		},
+		{
+			name:     "appended",
+			prevText: "@octocat requested an override of the merge chance.",
+			wantText: "@octocat requested an override of the merge chance.\n\n@aereal requested an override of the merge chance.",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			checkRun := &github.CheckRun{
+				ID:   github.Int64(42),
+				Name: github.String("merge-chance-time"),
+				Output: &github.CheckRunOutput{
+					Title:   github.String("closed"),
+					Summary: github.String("No merge window is scheduled."),
+				},
+			}
+			if tt.prevText != "" {
+				checkRun.Output.Text = github.String(tt.prevText)
+			}
+			var got github.UpdateCheckRunOptions
+			checks := githubapi.NewMockChecksService(ctrl)
+			checks.EXPECT().
+				UpdateCheckRun(gomock.Any(), "aereal", "example-repo", int64(42), gomock.Any()).
+				DoAndReturn(func(_ context.Context, _, _ string, _ int64, opts github.UpdateCheckRunOptions) (*github.CheckRun, *github.Response, error) {
+					got = opts
+					return checkRun, nil, nil
+				}).
+				Times(1)
+			client := githubapi.NewMockClient(ctrl)
+			client.EXPECT().Checks().Return(checks)
+
+			srv := &checkRunService{adminOrigin: adminOrigin}
+			if err := srv.AcknowledgeOverrideRequest(context.Background(), client, pr.GetBase().GetRepo(), checkRun, "aereal"); err != nil {
+				t.Fatal(err)
+			}
+			if got.Name != "merge-chance-time" || got.Output.GetTitle() != "closed" || got.Output.GetText() != tt.wantText {
+				t.Errorf("UpdateCheckRunOptions = name=%s title=%q text=%q, want text=%q", got.Name, got.Output.GetTitle(), got.Output.GetText(), tt.wantText)
+			}
+		})
+	}
+}
@@ -18,6 +18,7 @@ require (
 	github.com/google/go-github/v30 v30.1.0
 	github.com/mitchellh/mapstructure v1.3.0 // indirect
 	github.com/rs/cors v1.7.0
+	github.com/teambition/rrule-go v1.7.2
 	github.com/vektah/gqlparser/v2 v2.0.1
 	github.com/yfuruyama/stackdriver-request-context-log v0.0.1
 	go.opencensus.io v0.22.4
@@ -0,0 +1,225 @@
+package ical
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+const (
+	prodID = "-//mergechancetime.app//Merge Chance Time//EN"
+
+	// maxLineOctets is the limit of the length of a content line excluding the line break.
+	maxLineOctets = 75
+
+	// vtimezoneYears is how many years after the latest time in the calendar the observances of VTIMEZONE cover,
+	// so that clients expand recurring events with the right offsets.
+	vtimezoneYears = 5
+)
+
+// Encode writes the calendar as an iCalendar document.
+// stamp is written as DTSTAMP of each event.
+//
+// Times in time.Local are written in UTC because the name of the location is not an IANA time zone name.
+// Times in the other locations are written with TZID, and VTIMEZONE of each location is written with the offsets transitioning
+// from the year of the earliest time to vtimezoneYears after the latest time.
+func Encode(w io.Writer, calendar *Calendar, stamp time.Time) error {
+	bw := bufio.NewWriter(w)
+	lines := []string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"PRODID:" + prodID,
+		"CALSCALE:GREGORIAN",
+		"METHOD:PUBLISH",
+	}
+	if calendar.Name != "" {
+		lines = append(lines, "X-WR-CALNAME:"+escapeText(calendar.Name))
+	}
+	for _, tz := range timeZonesOf(calendar) {
+		lines = append(lines, tz.vtimezone()...)
+	}
+	for _, event := range calendar.Events {
+		lines = append(lines, "BEGIN:VEVENT")
+		lines = append(lines, "UID:"+event.UID)
+		lines = append(lines, "DTSTAMP:"+stamp.UTC().Format(utcDateTimeLayout))
+		lines = append(lines, formatDateTime("DTSTART", event.Start, event.AllDay))
+		lines = append(lines, formatDateTime("DTEND", event.End, event.AllDay))
+		if event.Summary != "" {
+			lines = append(lines, "SUMMARY:"+escapeText(event.Summary))
+		}
+		if event.RRule != "" {
+			lines = append(lines, "RRULE:"+event.RRule)
+		}
+		for _, exdate := range event.ExDates {
+			lines = append(lines, formatDateTime("EXDATE", exdate, event.AllDay))
+		}
+		if event.Cancelled {
+			lines = append(lines, "STATUS:CANCELLED")
+		}
+		lines = append(lines, "END:VEVENT")
+	}
+	lines = append(lines, "END:VCALENDAR")
+
+	for _, line := range lines {
+		if _, err := bw.WriteString(fold(line)); err != nil {
+			return err
+		}
+	}
+	return bw.Flush()
+}
+
+// timeZone is a location of DATE-TIME values written with TZID and the range of the values.
+type timeZone struct {
+	loc      *time.Location
+	earliest time.Time
+	latest   time.Time
+}
+
+// timeZonesOf returns the time zones of the calendar in the order of appearance.
+func timeZonesOf(calendar *Calendar) []*timeZone {
+	tzs := []*timeZone{}
+	byName := map[string]*timeZone{}
+	for _, event := range calendar.Events {
+		if event.AllDay {
+			continue
+		}
+		times := append([]time.Time{event.Start, event.End}, event.ExDates...)
+		for _, t := range times {
+			if !hasTZID(t) {
+				continue
+			}
+			tz, ok := byName[t.Location().String()]
+			if !ok {
+				tz = &timeZone{loc: t.Location(), earliest: t, latest: t}
+				byName[t.Location().String()] = tz
+				tzs = append(tzs, tz)
+			}
+			if t.Before(tz.earliest) {
+				tz.earliest = t
+			}
+			if t.After(tz.latest) {
+				tz.latest = t
+			}
+		}
+	}
+	return tzs
+}
+
+// observance is a period the location has the offset and the name of the zone.
+type observance struct {
+	start      time.Time
+	name       string
+	offset     int
+	offsetFrom int
+}
+
+// vtimezone returns the lines of VTIMEZONE component of the time zone.
+func (tz *timeZone) vtimezone() []string {
+	from := time.Date(tz.earliest.Year(), time.January, 1, 0, 0, 0, 0, tz.loc)
+	until := time.Date(tz.latest.Year()+vtimezoneYears, time.January, 1, 0, 0, 0, 0, tz.loc)
+	observances := zoneObservances(tz.loc, from, until)
+	standardOffset := observances[0].offset
+	for _, o := range observances {
+		if o.offset < standardOffset {
+			standardOffset = o.offset
+		}
+	}
+
+	lines := []string{"BEGIN:VTIMEZONE", "TZID:" + tz.loc.String()}
+	for _, o := range observances {
+		kind := "STANDARD"
+		if o.offset > standardOffset {
+			kind = "DAYLIGHT"
+		}
+		lines = append(lines,
+			"BEGIN:"+kind,
+			// DTSTART of an observance is the local time in the offset before it starts.
+			"DTSTART:"+o.start.In(time.FixedZone("", o.offsetFrom)).Format(localDateTimeLayout),
+			"TZOFFSETFROM:"+formatUTCOffset(o.offsetFrom),
+			"TZOFFSETTO:"+formatUTCOffset(o.offset),
+			"TZNAME:"+escapeText(o.name),
+			"END:"+kind,
+		)
+	}
+	return append(lines, "END:VTIMEZONE")
+}
+
+// zoneObservances returns the zone of loc at from and the transitions of the zones until until.
+// time.Location does not expose the transitions, so they are searched day by day and then to the second.
+func zoneObservances(loc *time.Location, from, until time.Time) []*observance {
+	name, offset := from.Zone()
+	observances := []*observance{{start: from, name: name, offset: offset, offsetFrom: offset}}
+	for day := from; day.Before(until); day = day.Add(24 * time.Hour) {
+		next := day.Add(24 * time.Hour)
+		nextName, nextOffset := next.Zone()
+		if nextName == name && nextOffset == offset {
+			continue
+		}
+		lo, hi := day.Unix(), next.Unix()
+		for hi-lo > 1 {
+			mid := lo + (hi-lo)/2
+			if n, o := time.Unix(mid, 0).In(loc).Zone(); n == name && o == offset {
+				lo = mid
+			} else {
+				hi = mid
+			}
+		}
+		observances = append(observances, &observance{start: time.Unix(hi, 0).In(loc), name: nextName, offset: nextOffset, offsetFrom: offset})
+		name, offset = nextName, nextOffset
+	}
+	return observances
+}
+
+// formatUTCOffset formats the offset in seconds east of UTC as UTC-OFFSET value (e.g. +0900).
+func formatUTCOffset(offset int) string {
+	sign := "+"
+	if offset < 0 {
+		sign = "-"
+		offset = -offset
+	}
+	if offset%60 != 0 {
+		return fmt.Sprintf("%s%02d%02d%02d", sign, offset/3600, offset%3600/60, offset%60)
+	}
+	return fmt.Sprintf("%s%02d%02d", sign, offset/3600, offset%3600/60)
+}
+
+// hasTZID reports whether the DATE-TIME value of t is written with TZID.
+func hasTZID(t time.Time) bool {
+	return t.Location() != time.UTC && t.Location() != time.Local
+}
+
+func formatDateTime(name string, t time.Time, allDay bool) string {
+	switch {
+	case allDay:
+		return fmt.Sprintf("%s;VALUE=DATE:%s", name, t.Format(dateLayout))
+	case !hasTZID(t):
+		return fmt.Sprintf("%s:%s", name, t.UTC().Format(utcDateTimeLayout))
+	default:
+		return fmt.Sprintf("%s;TZID=%s:%s", name, t.Location(), t.Format(localDateTimeLayout))
+	}
+}
+
+// fold splits the line into lines no longer than maxLineOctets without breaking multi-byte characters.
+func fold(line string) string {
+	var b strings.Builder
+	width := 0
+	for _, r := range line {
+		size := len(string(r))
+		if width+size > maxLineOctets {
+			b.WriteString("\r\n ")
+			width = 1
+		}
+		b.WriteRune(r)
+		width += size
+	}
+	b.WriteString("\r\n")
+	return b.String()
+}
+
+var textEscaper = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\n", `\n`)
+
+func escapeText(s string) string {
+	return textEscaper.Replace(s)
+}
@@ -0,0 +1,408 @@
+package ical
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/teambition/rrule-go"
+)
+
+const (
+	dateLayout          = "20060102"
+	localDateTimeLayout = "20060102T150405"
+	utcDateTimeLayout   = "20060102T150405Z"
+
+	// maxOccurrences bounds the occurrences of a recurring event returned in a period.
+	maxOccurrences = 1000
+	// maxIterations bounds the instances of a recurring event walked to find the occurrences in a period,
+	// so that an event recurring frequently for a long time does not take too long to expand.
+	maxIterations = 100000
+)
+
+// fastForwardUnits are the lengths of the frequencies whose instances are fast-forwarded to the period,
+// because they recur too often to walk from long ago.
+var fastForwardUnits = map[rrule.Frequency]time.Duration{
+	rrule.HOURLY:   time.Hour,
+	rrule.MINUTELY: time.Minute,
+	rrule.SECONDLY: time.Second,
+}
+
+// Calendar is a VCALENDAR object.
+type Calendar struct {
+	// Name is the value of X-WR-CALNAME property.
+	Name   string
+	Events []*Event
+}
+
+// Event is a VEVENT component.
+type Event struct {
+	UID     string
+	Summary string
+	Start   time.Time
+	End     time.Time
+	// AllDay is true if DTSTART is a DATE value.
+	AllDay bool
+	// RRule is the value of RRULE property if the event recurs.
+	RRule   string
+	ExDates []time.Time
+	// Cancelled is true if STATUS is CANCELLED.
+	Cancelled bool
+}
+
+// Occurrence is a period an event takes place.
+type Occurrence struct {
+	Start time.Time
+	End   time.Time
+	Event *Event
+}
+
+type property struct {
+	name   string
+	params map[string]string
+	value  string
+}
+
+// Parse parses an iCalendar document.
+//
+// DATE values and DATE-TIME values without time zone are interpreted in X-WR-TIMEZONE of the calendar if declared,
+// or defaultLocation otherwise. TZID parameters must be IANA time zone names.
+func Parse(r io.Reader, defaultLocation *time.Location) (*Calendar, error) {
+	lines, err := unfold(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		calendarProps = []*property{}
+		eventProps    = [][]*property{}
+		stack         = []string{}
+	)
+	for i, line := range lines {
+		prop, err := parseProperty(line)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", i+1, err)
+		}
+		switch prop.name {
+		case "BEGIN":
+			stack = append(stack, strings.ToUpper(prop.value))
+			if len(stack) == 2 && stack[1] == "VEVENT" {
+				eventProps = append(eventProps, []*property{})
+			}
+			continue
+		case "END":
+			if len(stack) == 0 || stack[len(stack)-1] != strings.ToUpper(prop.value) {
+				return nil, fmt.Errorf("line %d: unexpected END:%s", i+1, prop.value)
+			}
+			stack = stack[:len(stack)-1]
+			continue
+		}
+		switch {
+		case len(stack) == 1 && stack[0] == "VCALENDAR":
+			calendarProps = append(calendarProps, prop)
+		case len(stack) == 2 && stack[1] == "VEVENT":
+			eventProps[len(eventProps)-1] = append(eventProps[len(eventProps)-1], prop)
+		}
+	}
+	if len(stack) != 0 {
+		return nil, fmt.Errorf("unterminated %s", stack[len(stack)-1])
+	}
+
+	calendar := &Calendar{Events: []*Event{}}
+	loc := defaultLocation
+	for _, prop := range calendarProps {
+		switch prop.name {
+		case "X-WR-TIMEZONE":
+			if loc, err = time.LoadLocation(prop.value); err != nil {
+				return nil, fmt.Errorf("invalid X-WR-TIMEZONE: %w", err)
+			}
+		case "X-WR-CALNAME":
+			calendar.Name = unescapeText(prop.value)
+		}
+	}
+
+	for _, props := range eventProps {
+		event, err := newEvent(props, loc)
+		if err != nil {
+			return nil, err
+		}
+		calendar.Events = append(calendar.Events, event)
+	}
+	return calendar, nil
+}
+
+func newEvent(props []*property, loc *time.Location) (*Event, error) {
+	event := &Event{ExDates: []time.Time{}}
+	var (
+		hasEnd   bool
+		duration time.Duration
+	)
+	for _, prop := range props {
+		var err error
+		switch prop.name {
+		case "UID":
+			event.UID = prop.value
+		case "SUMMARY":
+			event.Summary = unescapeText(prop.value)
+		case "STATUS":
+			event.Cancelled = strings.EqualFold(prop.value, "CANCELLED")
+		case "DTSTART":
+			event.Start, event.AllDay, err = parseDateTime(prop, loc)
+		case "DTEND":
+			event.End, _, err = parseDateTime(prop, loc)
+			hasEnd = true
+		case "DURATION":
+			duration, err = parseDuration(prop.value)
+		case "RRULE":
+			event.RRule = prop.value
+		case "EXDATE":
+			for _, v := range strings.Split(prop.value, ",") {
+				var exdate time.Time
+				exdate, _, err = parseDateTime(&property{name: prop.name, params: prop.params, value: v}, loc)
+				if err != nil {
+					break
+				}
+				event.ExDates = append(event.ExDates, exdate)
+			}
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s of event %q: %w", prop.name, event.UID, err)
+		}
+	}
+	if event.Start.IsZero() {
+		return nil, fmt.Errorf("event %q has no DTSTART", event.UID)
+	}
+	switch {
+	case hasEnd:
+	case duration != 0:
+		event.End = event.Start.Add(duration)
+	case event.AllDay:
+		event.End = event.Start.AddDate(0, 0, 1)
+	default:
+		event.End = event.Start
+	}
+	if event.End.Before(event.Start) {
+		return nil, fmt.Errorf("event %q ends before it starts", event.UID)
+	}
+	return event, nil
+}
+
+// Occurrences returns the occurrences of the event overlapping the period between from and until.
+// It returns an error instead of dropping occurrences if the event occurs more than maxOccurrences times in the period,
+// or if more than maxIterations instances must be walked to find them.
+func (e *Event) Occurrences(from, until time.Time) ([]*Occurrence, error) {
+	occurrences := []*Occurrence{}
+	if e.Cancelled {
+		return occurrences, nil
+	}
+	length := e.End.Sub(e.Start)
+	if e.RRule == "" {
+		if e.Start.Before(until) && e.End.After(from) {
+			occurrences = append(occurrences, &Occurrence{Start: e.Start, End: e.End, Event: e})
+		}
+		return occurrences, nil
+	}
+
+	opt, err := rrule.StrToROptionInLocation(e.RRule, e.Start.Location())
+	if err != nil {
+		return nil, fmt.Errorf("invalid RRULE of event %q: %w", e.UID, err)
+	}
+	opt.Dtstart = fastForward(opt, e.Start, from.Add(-length))
+	rule, err := rrule.NewRRule(*opt)
+	if err != nil {
+		return nil, fmt.Errorf("invalid RRULE of event %q: %w", e.UID, err)
+	}
+	set := &rrule.Set{}
+	set.RRule(rule)
+	set.RDate(e.Start)
+	set.SetExDates(e.ExDates)
+	next := set.Iterator()
+	// occurrences ended before from are skipped without counting, so that the events started long ago are expanded up to the period
+	for i := 0; ; i++ {
+		start, ok := next()
+		if !ok || !start.Before(until) {
+			break
+		}
+		if i == maxIterations {
+			return nil, fmt.Errorf("event %q recurs more than %d times before %s", e.UID, maxIterations, until.Format(time.RFC3339))
+		}
+		end := start.Add(length)
+		if e.AllDay {
+			end = start.AddDate(0, 0, daysBetween(e.Start, e.End))
+		}
+		if !end.After(from) {
+			continue
+		}
+		if len(occurrences) == maxOccurrences {
+			return nil, fmt.Errorf("event %q occurs more than %d times in the period", e.UID, maxOccurrences)
+		}
+		occurrences = append(occurrences, &Occurrence{Start: start, End: end, Event: e})
+	}
+	return occurrences, nil
+}
+
+// fastForward returns the instance of the rule at or before after closest to it, to start walking the instances from.
+// Only the rules of fastForwardUnits without COUNT are fast-forwarded, because their instances are aligned to the interval from start,
+// and COUNT counts the instances from start. The others return start.
+func fastForward(opt *rrule.ROption, start, after time.Time) time.Time {
+	unit, ok := fastForwardUnits[opt.Freq]
+	if !ok || opt.Count != 0 || !start.Before(after) {
+		return start
+	}
+	interval := opt.Interval
+	if interval < 1 {
+		interval = 1
+	}
+	period := time.Duration(interval) * unit
+	return start.Add(after.Sub(start) / period * period)
+}
+
+// Occurrences returns the occurrences of all events in the calendar overlapping the period between from and until.
+func (c *Calendar) Occurrences(from, until time.Time) ([]*Occurrence, error) {
+	occurrences := []*Occurrence{}
+	for _, event := range c.Events {
+		os, err := event.Occurrences(from, until)
+		if err != nil {
+			return nil, err
+		}
+		occurrences = append(occurrences, os...)
+	}
+	return occurrences, nil
+}
+
+// daysBetween returns the number of calendar days from start to end regardless of DST transitions.
+func daysBetween(start, end time.Time) int {
+	s := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, time.UTC)
+	e := time.Date(end.Year(), end.Month(), end.Day(), 0, 0, 0, 0, time.UTC)
+	return int(e.Sub(s).Hours() / 24)
+}
+
+func unfold(r io.Reader) ([]string, error) {
+	lines := []string{}
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if line == "" {
+			continue
+		}
+		if (line[0] == ' ' || line[0] == '\t') && len(lines) > 0 {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		lines = append(lines, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return lines, nil
+}
+
+func parseProperty(line string) (*property, error) {
+	prop := &property{params: map[string]string{}}
+	inQuote := false
+	nameEnd, valueStart := -1, -1
+	for i, c := range line {
+		switch {
+		case c == '"':
+			inQuote = !inQuote
+		case c == ';' && !inQuote && nameEnd < 0:
+			nameEnd = i
+		case c == ':' && !inQuote:
+			valueStart = i + 1
+		}
+		if valueStart >= 0 {
+			break
+		}
+	}
+	if valueStart < 0 {
+		return nil, fmt.Errorf("malformed content line %q", line)
+	}
+	head := line[:valueStart-1]
+	if nameEnd < 0 {
+		nameEnd = len(head)
+	}
+	prop.name = strings.ToUpper(head[:nameEnd])
+	prop.value = line[valueStart:]
+	if nameEnd < len(head) {
+		for _, param := range splitParams(head[nameEnd+1:]) {
+			kv := strings.SplitN(param, "=", 2)
+			if len(kv) != 2 {
+				return nil, fmt.Errorf("malformed parameter %q", param)
+			}
+			prop.params[strings.ToUpper(kv[0])] = strings.Trim(kv[1], `"`)
+		}
+	}
+	return prop, nil
+}
+
+func splitParams(s string) []string {
+	params := []string{}
+	inQuote := false
+	start := 0
+	for i, c := range s {
+		switch {
+		case c == '"':
+			inQuote = !inQuote
+		case c == ';' && !inQuote:
+			params = append(params, s[start:i])
+			start = i + 1
+		}
+	}
+	return append(params, s[start:])
+}
+
+func parseDateTime(prop *property, loc *time.Location) (t time.Time, allDay bool, err error) {
+	if tzid, ok := prop.params["TZID"]; ok {
+		if loc, err = time.LoadLocation(tzid); err != nil {
+			return time.Time{}, false, err
+		}
+	}
+	value := strings.TrimSpace(prop.value)
+	switch {
+	case prop.params["VALUE"] == "DATE" || len(value) == len(dateLayout):
+		t, err = time.ParseInLocation(dateLayout, value, loc)
+		return t, true, err
+	case strings.HasSuffix(value, "Z"):
+		t, err = time.Parse(utcDateTimeLayout, value)
+		return t, false, err
+	default:
+		t, err = time.ParseInLocation(localDateTimeLayout, value, loc)
+		return t, false, err
+	}
+}
+
+var durationPattern = regexp.MustCompile(`^([+-])?P(?:(\d+)W)?(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+)S)?)?$`)
+
+func parseDuration(s string) (time.Duration, error) {
+	m := durationPattern.FindStringSubmatch(strings.TrimSpace(s))
+	if m == nil || s == "P" || strings.HasSuffix(s, "T") {
+		return 0, fmt.Errorf("malformed duration %q", s)
+	}
+	units := []time.Duration{7 * 24 * time.Hour, 24 * time.Hour, time.Hour, time.Minute, time.Second}
+	var d time.Duration
+	for i, unit := range units {
+		if m[i+2] == "" {
+			continue
+		}
+		n, err := strconv.Atoi(m[i+2])
+		if err != nil {
+			return 0, err
+		}
+		d += time.Duration(n) * unit
+	}
+	if m[1] == "-" {
+		d = -d
+	}
+	return d, nil
+}
+
+var textUnescaper = strings.NewReplacer(`\n`, "\n", `\N`, "\n", `\,`, ",", `\;`, ";", `\\`, `\`)
+
+func unescapeText(s string) string {
+	return textUnescaper.Replace(s)
+}
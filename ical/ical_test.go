@@ -0,0 +1,462 @@
+package ical
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func mustParseTime(s string) time.Time {
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+func mustLoadLocation(name string) *time.Location {
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		panic(err)
+	}
+	return loc
+}
+
+func lines(ls ...string) string {
+	return strings.Join(ls, "\r\n") + "\r\n"
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		ics     string
+		loc     *time.Location
+		want    []*Event
+		wantErr bool
+	}{
+		{
+			name: "UTC",
+			ics: lines(
+				"BEGIN:VCALENDAR",
+				"BEGIN:VEVENT",
+				"UID:1",
+				"SUMMARY:Release freeze",
+				"DTSTART:20200203T100000Z",
+				"DTEND:20200203T120000Z",
+				"END:VEVENT",
+				"END:VCALENDAR",
+			),
+			loc: time.UTC,
+			want: []*Event{
+				{UID: "1", Summary: "Release freeze", Start: mustParseTime("2020-02-03T10:00:00Z"), End: mustParseTime("2020-02-03T12:00:00Z")},
+			},
+		},
+		{
+			name: "TZID and duration",
+			ics: lines(
+				"BEGIN:VCALENDAR",
+				"BEGIN:VEVENT",
+				"UID:1",
+				`DTSTART;TZID="Asia/Tokyo":20200203T100000`,
+				"DURATION:PT1H30M",
+				"END:VEVENT",
+				"END:VCALENDAR",
+			),
+			loc: time.UTC,
+			want: []*Event{
+				{UID: "1", Start: mustParseTime("2020-02-03T10:00:00+09:00"), End: mustParseTime("2020-02-03T11:30:00+09:00")},
+			},
+		},
+		{
+			name: "floating time in X-WR-TIMEZONE",
+			ics: lines(
+				"BEGIN:VCALENDAR",
+				"X-WR-TIMEZONE:Asia/Tokyo",
+				"BEGIN:VEVENT",
+				"UID:1",
+				"DTSTART:20200203T100000",
+				"DTEND:20200203T110000",
+				"END:VEVENT",
+				"END:VCALENDAR",
+			),
+			loc: time.UTC,
+			want: []*Event{
+				{UID: "1", Start: mustParseTime("2020-02-03T10:00:00+09:00"), End: mustParseTime("2020-02-03T11:00:00+09:00")},
+			},
+		},
+		{
+			name: "all day in default location",
+			ics: lines(
+				"BEGIN:VCALENDAR",
+				"BEGIN:VEVENT",
+				"UID:1",
+				"DTSTART;VALUE=DATE:20200203",
+				"END:VEVENT",
+				"END:VCALENDAR",
+			),
+			loc: mustLoadLocation("Asia/Tokyo"),
+			want: []*Event{
+				{UID: "1", AllDay: true, Start: mustParseTime("2020-02-03T00:00:00+09:00"), End: mustParseTime("2020-02-04T00:00:00+09:00")},
+			},
+		},
+		{
+			name: "folded lines, escaped text and nested components",
+			ics: lines(
+				"BEGIN:VCALENDAR",
+				"BEGIN:VEVENT",
+				"UID:1",
+				"SUMMARY:Release\\, freeze",
+				"  of v2",
+				"DTSTART:20200203T100000Z",
+				"DTEND:20200203T120000Z",
+				"STATUS:CANCELLED",
+				"BEGIN:VALARM",
+				"TRIGGER:-PT15M",
+				"END:VALARM",
+				"END:VEVENT",
+				"END:VCALENDAR",
+			),
+			loc: time.UTC,
+			want: []*Event{
+				{UID: "1", Summary: "Release, freeze of v2", Start: mustParseTime("2020-02-03T10:00:00Z"), End: mustParseTime("2020-02-03T12:00:00Z"), Cancelled: true},
+			},
+		},
+		{
+			name: "no DTSTART",
+			ics: lines(
+				"BEGIN:VCALENDAR",
+				"BEGIN:VEVENT",
+				"UID:1",
+				"END:VEVENT",
+				"END:VCALENDAR",
+			),
+			loc:     time.UTC,
+			wantErr: true,
+		},
+		{
+			name: "unterminated",
+			ics: lines(
+				"BEGIN:VCALENDAR",
+				"BEGIN:VEVENT",
+				"UID:1",
+				"DTSTART:20200203T100000Z",
+				"END:VCALENDAR",
+			),
+			loc:     time.UTC,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(strings.NewReader(tt.ics), tt.loc)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Parse() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if len(got.Events) != len(tt.want) {
+				t.Fatalf("len(Events) = %d, want %d", len(got.Events), len(tt.want))
+			}
+			for i, want := range tt.want {
+				e := got.Events[i]
+				if e.UID != want.UID || e.Summary != want.Summary || e.AllDay != want.AllDay || e.Cancelled != want.Cancelled {
+					t.Errorf("Events[%d] = %#v, want %#v", i, e, want)
+				}
+				if !e.Start.Equal(want.Start) || !e.End.Equal(want.End) {
+					t.Errorf("Events[%d] = %s - %s, want %s - %s", i, e.Start, e.End, want.Start, want.End)
+				}
+			}
+		})
+	}
+}
+
+func TestCalendar_Occurrences(t *testing.T) {
+	type period struct{ start, end string }
+	tests := []struct {
+		name    string
+		ics     string
+		from    string
+		until   string
+		want    []period
+		wantErr string
+	}{
+		{
+			name: "single event overlapping",
+			ics: lines(
+				"BEGIN:VCALENDAR",
+				"BEGIN:VEVENT",
+				"DTSTART:20200203T100000Z",
+				"DTEND:20200203T120000Z",
+				"END:VEVENT",
+				"END:VCALENDAR",
+			),
+			from:  "2020-02-03T11:00:00Z",
+			until: "2020-02-04T00:00:00Z",
+			want:  []period{{"2020-02-03T10:00:00Z", "2020-02-03T12:00:00Z"}},
+		},
+		{
+			name: "single event ended",
+			ics: lines(
+				"BEGIN:VCALENDAR",
+				"BEGIN:VEVENT",
+				"DTSTART:20200203T100000Z",
+				"DTEND:20200203T120000Z",
+				"END:VEVENT",
+				"END:VCALENDAR",
+			),
+			from:  "2020-02-03T12:00:00Z",
+			until: "2020-02-04T00:00:00Z",
+			want:  []period{},
+		},
+		{
+			name: "weekly with EXDATE",
+			ics: lines(
+				"BEGIN:VCALENDAR",
+				"BEGIN:VEVENT",
+				"DTSTART;TZID=Asia/Tokyo:20200207T180000",
+				"DTEND;TZID=Asia/Tokyo:20200207T235900",
+				"RRULE:FREQ=WEEKLY;BYDAY=FR;COUNT=4",
+				"EXDATE;TZID=Asia/Tokyo:20200214T180000",
+				"END:VEVENT",
+				"END:VCALENDAR",
+			),
+			from:  "2020-02-01T00:00:00Z",
+			until: "2020-03-01T00:00:00Z",
+			want: []period{
+				{"2020-02-07T18:00:00+09:00", "2020-02-07T23:59:00+09:00"},
+				{"2020-02-21T18:00:00+09:00", "2020-02-21T23:59:00+09:00"},
+				{"2020-02-28T18:00:00+09:00", "2020-02-28T23:59:00+09:00"},
+			},
+		},
+		{
+			name: "daily all day across DST",
+			ics: lines(
+				"BEGIN:VCALENDAR",
+				"X-WR-TIMEZONE:America/Los_Angeles",
+				"BEGIN:VEVENT",
+				"DTSTART;VALUE=DATE:20200307",
+				"DTEND;VALUE=DATE:20200308",
+				"RRULE:FREQ=DAILY;UNTIL=20200309",
+				"END:VEVENT",
+				"END:VCALENDAR",
+			),
+			from:  "2020-03-07T00:00:00Z",
+			until: "2020-03-10T00:00:00Z",
+			want: []period{
+				{"2020-03-07T00:00:00-08:00", "2020-03-08T00:00:00-08:00"},
+				{"2020-03-08T00:00:00-08:00", "2020-03-09T00:00:00-07:00"},
+				{"2020-03-09T00:00:00-07:00", "2020-03-10T00:00:00-07:00"},
+			},
+		},
+		{
+			name: "daily started years ago",
+			ics: lines(
+				"BEGIN:VCALENDAR",
+				"BEGIN:VEVENT",
+				"DTSTART:20170101T220000Z",
+				"DTEND:20170101T230000Z",
+				"RRULE:FREQ=DAILY",
+				"END:VEVENT",
+				"END:VCALENDAR",
+			),
+			from:  "2020-02-03T00:00:00Z",
+			until: "2020-02-05T00:00:00Z",
+			want: []period{
+				{"2020-02-03T22:00:00Z", "2020-02-03T23:00:00Z"},
+				{"2020-02-04T22:00:00Z", "2020-02-04T23:00:00Z"},
+			},
+		},
+		{
+			name: "minutely started years ago",
+			ics: lines(
+				"BEGIN:VCALENDAR",
+				"BEGIN:VEVENT",
+				"DTSTART:20000101T000500Z",
+				"DTEND:20000101T001000Z",
+				"RRULE:FREQ=MINUTELY;INTERVAL=20",
+				"END:VEVENT",
+				"END:VCALENDAR",
+			),
+			from:  "2020-02-03T00:07:00Z",
+			until: "2020-02-03T01:00:00Z",
+			want: []period{
+				{"2020-02-03T00:05:00Z", "2020-02-03T00:10:00Z"},
+				{"2020-02-03T00:25:00Z", "2020-02-03T00:30:00Z"},
+				{"2020-02-03T00:45:00Z", "2020-02-03T00:50:00Z"},
+			},
+		},
+		{
+			name: "too many occurrences in the period",
+			ics: lines(
+				"BEGIN:VCALENDAR",
+				"BEGIN:VEVENT",
+				"UID:secondly@example.com",
+				"DTSTART:20200203T000000Z",
+				"DTEND:20200203T000001Z",
+				"RRULE:FREQ=SECONDLY",
+				"END:VEVENT",
+				"END:VCALENDAR",
+			),
+			from:    "2020-02-03T00:00:00Z",
+			until:   "2020-02-04T00:00:00Z",
+			wantErr: `event "secondly@example.com" occurs more than 1000 times in the period`,
+		},
+		{
+			name: "too many instances before the period",
+			ics: lines(
+				"BEGIN:VCALENDAR",
+				"BEGIN:VEVENT",
+				"UID:daily@example.com",
+				"DTSTART:17000101T000000Z",
+				"DTEND:17000101T010000Z",
+				"RRULE:FREQ=DAILY",
+				"END:VEVENT",
+				"END:VCALENDAR",
+			),
+			from:    "2020-02-03T00:00:00Z",
+			until:   "2020-02-04T00:00:00Z",
+			wantErr: `event "daily@example.com" recurs more than 100000 times before 2020-02-04T00:00:00Z`,
+		},
+		{
+			name: "cancelled",
+			ics: lines(
+				"BEGIN:VCALENDAR",
+				"BEGIN:VEVENT",
+				"DTSTART:20200203T100000Z",
+				"DTEND:20200203T120000Z",
+				"STATUS:CANCELLED",
+				"END:VEVENT",
+				"END:VCALENDAR",
+			),
+			from:  "2020-02-01T00:00:00Z",
+			until: "2020-03-01T00:00:00Z",
+			want:  []period{},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			cal, err := Parse(strings.NewReader(tt.ics), time.UTC)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := cal.Occurrences(mustParseTime(tt.from), mustParseTime(tt.until))
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Fatalf("error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("len(Occurrences()) = %d, want %d", len(got), len(tt.want))
+			}
+			for i, want := range tt.want {
+				if !got[i].Start.Equal(mustParseTime(want.start)) || !got[i].End.Equal(mustParseTime(want.end)) {
+					t.Errorf("Occurrences()[%d] = %s - %s, want %s - %s", i, got[i].Start, got[i].End, want.start, want.end)
+				}
+			}
+		})
+	}
+}
+
+func TestEncode(t *testing.T) {
+	tokyo := mustLoadLocation("Asia/Tokyo")
+	calendar := &Calendar{
+		Name: "Merge chances of aereal/example-repo",
+		Events: []*Event{
+			{
+				UID:     "weekly@example.com",
+				Summary: "Merges open; " + strings.Repeat("long summary ", 10),
+				Start:   time.Date(2020, time.February, 3, 10, 0, 0, 0, tokyo),
+				End:     time.Date(2020, time.February, 3, 18, 0, 0, 0, tokyo),
+				RRule:   "FREQ=WEEKLY",
+				ExDates: []time.Time{time.Date(2020, time.February, 10, 10, 0, 0, 0, tokyo)},
+			},
+			{
+				UID:    "all-day@example.com",
+				Start:  time.Date(2020, time.February, 11, 0, 0, 0, 0, tokyo),
+				End:    time.Date(2020, time.February, 12, 0, 0, 0, 0, tokyo),
+				AllDay: true,
+			},
+		},
+	}
+	buf := new(strings.Builder)
+	if err := Encode(buf, calendar, mustParseTime("2020-02-01T00:00:00Z")); err != nil {
+		t.Fatal(err)
+	}
+	for _, line := range strings.Split(buf.String(), "\r\n") {
+		if len(line) > maxLineOctets {
+			t.Errorf("line longer than %d octets: %q", maxLineOctets, line)
+		}
+	}
+
+	// DATE values are floating, so they are read in the location they were written in.
+	got, err := Parse(strings.NewReader(buf.String()), tokyo)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Name != calendar.Name {
+		t.Errorf("Name = %q, want %q", got.Name, calendar.Name)
+	}
+	if len(got.Events) != len(calendar.Events) {
+		t.Fatalf("len(Events) = %d, want %d", len(got.Events), len(calendar.Events))
+	}
+	for i, want := range calendar.Events {
+		e := got.Events[i]
+		if e.UID != want.UID || e.Summary != want.Summary || e.AllDay != want.AllDay || e.RRule != want.RRule || len(e.ExDates) != len(want.ExDates) {
+			t.Errorf("Events[%d] = %#v, want %#v", i, e, want)
+		}
+		if !e.Start.Equal(want.Start) || !e.End.Equal(want.End) {
+			t.Errorf("Events[%d] = %s - %s, want %s - %s", i, e.Start, e.End, want.Start, want.End)
+		}
+	}
+}
+
+func TestEncode_vtimezone(t *testing.T) {
+	la := mustLoadLocation("America/Los_Angeles")
+	calendar := &Calendar{
+		Events: []*Event{
+			{
+				UID:   "weekly@example.com",
+				Start: time.Date(2020, time.February, 3, 10, 0, 0, 0, la),
+				End:   time.Date(2020, time.February, 3, 18, 0, 0, 0, la),
+				RRule: "FREQ=WEEKLY",
+			},
+			{
+				UID:   "utc@example.com",
+				Start: time.Date(2020, time.February, 4, 10, 0, 0, 0, time.UTC),
+				End:   time.Date(2020, time.February, 4, 18, 0, 0, 0, time.UTC),
+			},
+		},
+	}
+	buf := new(strings.Builder)
+	if err := Encode(buf, calendar, mustParseTime("2020-02-01T00:00:00Z")); err != nil {
+		t.Fatal(err)
+	}
+	doc := buf.String()
+	if n := strings.Count(doc, "BEGIN:VTIMEZONE\r\n"); n != 1 {
+		t.Errorf("VTIMEZONE written %d times, want once:\n%s", n, doc)
+	}
+	for _, want := range []string{
+		"BEGIN:VTIMEZONE\r\nTZID:America/Los_Angeles\r\n",
+		// the zone at the beginning of the year of the event
+		"BEGIN:STANDARD\r\nDTSTART:20200101T000000\r\nTZOFFSETFROM:-0800\r\nTZOFFSETTO:-0800\r\nTZNAME:PST\r\nEND:STANDARD\r\n",
+		"BEGIN:DAYLIGHT\r\nDTSTART:20200308T020000\r\nTZOFFSETFROM:-0800\r\nTZOFFSETTO:-0700\r\nTZNAME:PDT\r\nEND:DAYLIGHT\r\n",
+		"BEGIN:STANDARD\r\nDTSTART:20201101T020000\r\nTZOFFSETFROM:-0700\r\nTZOFFSETTO:-0800\r\nTZNAME:PST\r\nEND:STANDARD\r\n",
+		// transitions are covered for years after the event because it recurs
+		"BEGIN:DAYLIGHT\r\nDTSTART:20240310T020000\r\n",
+		"DTSTART;TZID=America/Los_Angeles:20200203T100000\r\n",
+	} {
+		if !strings.Contains(doc, want) {
+			t.Errorf("document does not contain %q:\n%s", want, doc)
+		}
+	}
+	if strings.Contains(doc, "TZID:UTC") {
+		t.Errorf("VTIMEZONE written for UTC:\n%s", doc)
+	}
+}
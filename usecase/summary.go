@@ -0,0 +1,84 @@
+package usecase
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aereal/merge-chance-time/domain/model"
+)
+
+// UpdateChanceTimeSummary is the outcome of UpdateChanceTime on each repository.
+// Repositories are denoted by their full names (e.g. "aereal/merge-chance-time").
+type UpdateChanceTimeSummary struct {
+	// Updated are the repositories whose commit statuses are updated to the reconciled merge chances.
+	Updated []string `json:"updated"`
+	// Quarantined are the repositories whose configs are quarantined on this run because the app is not installed on the owner.
+	Quarantined []string `json:"quarantined"`
+	// Released are the repositories whose configs are released from the quarantine because the app is installed again.
+	Released []string `json:"released"`
+	// Deleted are the repositories whose configs are deleted because they have been quarantined longer than the retention.
+	Deleted []string `json:"deleted"`
+	// Failures are the repositories failed to be updated. They do not prevent the others from being updated.
+	Failures []*RepositoryFailure `json:"failures"`
+}
+
+type RepositoryFailure struct {
+	Repository string `json:"repository"`
+	Error      string `json:"error"`
+}
+
+func newUpdateChanceTimeSummary() *UpdateChanceTimeSummary {
+	return &UpdateChanceTimeSummary{
+		Updated:     []string{},
+		Quarantined: []string{},
+		Released:    []string{},
+		Deleted:     []string{},
+		Failures:    []*RepositoryFailure{},
+	}
+}
+
+// Err returns the error aggregating the failures, or nil if no repository failed.
+func (s *UpdateChanceTimeSummary) Err() error {
+	if len(s.Failures) == 0 {
+		return nil
+	}
+	msgs := make([]string, len(s.Failures))
+	for i, f := range s.Failures {
+		msgs[i] = fmt.Sprintf("%s: %s", f.Repository, f.Error)
+	}
+	return fmt.Errorf("failed to update %d repositories: %s", len(s.Failures), strings.Join(msgs, "; "))
+}
+
+// add records the outcome of the transition.
+func (s *UpdateChanceTimeSummary) add(tr *transition) {
+	name := fullName(tr.config)
+	switch {
+	case tr.err != nil:
+		s.Failures = append(s.Failures, &RepositoryFailure{Repository: name, Error: tr.err.Error()})
+		return
+	case tr.quarantined:
+		s.Quarantined = append(s.Quarantined, name)
+	case tr.deleted:
+		s.Deleted = append(s.Deleted, name)
+	}
+	if tr.released {
+		s.Released = append(s.Released, name)
+	}
+	if tr.reconciled {
+		s.Updated = append(s.Updated, name)
+	}
+}
+
+// sort sorts the repositories by name, because the configs are listed in no particular order.
+func (s *UpdateChanceTimeSummary) sort() {
+	sort.Strings(s.Updated)
+	sort.Strings(s.Quarantined)
+	sort.Strings(s.Released)
+	sort.Strings(s.Deleted)
+	sort.Slice(s.Failures, func(i, j int) bool { return s.Failures[i].Repository < s.Failures[j].Repository })
+}
+
+func fullName(cfg *model.RepositoryConfig) string {
+	return cfg.Owner + "/" + cfg.Name
+}
@@ -6,6 +6,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/aereal/merge-chance-time/app/adapter/githubapi"
@@ -13,36 +14,61 @@ import (
 	"github.com/aereal/merge-chance-time/domain/model"
 	"github.com/aereal/merge-chance-time/domain/repo"
 	"github.com/aereal/merge-chance-time/domain/service"
+	"github.com/aereal/merge-chance-time/ical"
 	"github.com/aereal/merge-chance-time/logging"
 	"github.com/google/go-github/v30/github"
 	"golang.org/x/sync/errgroup"
 )
 
+// openPullRequests lists the open pull requests whose statuses are updated.
+var openPullRequests = &github.PullRequestListOptions{State: "open"}
+
 var (
 	ErrInvalidInput         = fmt.Errorf("invalid input")
 	ErrInstallationNotFound = fmt.Errorf("repository installation not found")
 	ErrConfigNotFound       = fmt.Errorf("repository config not found")
+	ErrFeedRevoked          = fmt.Errorf("calendar feed token revoked")
 )
 
-func New(repo repo.Repository) (Usecase, error) {
+// orphanRetention is how long the quarantined config of the owner without installation is kept,
+// so that the config survives reinstalling the app.
+const orphanRetention = 30 * 24 * time.Hour
+
+// DefaultMaxConcurrencyPerInstallation is the default number of repositories of an installation whose statuses are updated at once.
+const DefaultMaxConcurrencyPerInstallation = 4
+
+func New(repo repo.Repository, srv service.Service) (Usecase, error) {
 	if repo == nil {
 		return nil, fmt.Errorf("repo is nil")
 	}
+	if srv == nil {
+		return nil, fmt.Errorf("service is nil")
+	}
 	return &usecaseImpl{
-		repo: repo,
+		repo:                          repo,
+		srv:                           srv,
+		maxConcurrencyPerInstallation: DefaultMaxConcurrencyPerInstallation,
 	}, nil
 }
 
 type usecaseImpl struct {
 	repo repo.Repository
+	srv  service.Service
+	// maxConcurrencyPerInstallation bounds the requests made at once with the token of an installation,
+	// so that flipping many repositories at once does not hit the secondary rate limit. Zero means no limit.
+	maxConcurrencyPerInstallation int
 }
 
 type Usecase interface {
 	OnDeleteAppFromOwner(ctx context.Context, owner string) error
 	OnRemoveRepositories(ctx context.Context, repos []*github.Repository) error
 	OnInstallRepositories(ctx context.Context, repos []*github.Repository) error
-	UpdateChanceTime(ctx context.Context, adapter githubapps.GitHubAppsAdapter, baseTime time.Time) error
+	UpdateChanceTime(ctx context.Context, adapter githubapps.GitHubAppsAdapter, baseTime time.Time) (*UpdateChanceTimeSummary, error)
 	UpdatePullRequestCommitStatus(ctx context.Context, client githubapi.Client, pr *github.PullRequest) error
+	CalendarFeed(ctx context.Context, owner, name string, generation int, now time.Time) (*ical.Calendar, error)
+	ForceMergeWindow(ctx context.Context, adapter githubapps.GitHubAppsAdapter, owner, name string, override *model.Override) error
+	RequestOverride(ctx context.Context, client githubapi.Client, repo *github.Repository, checkRun *github.CheckRun, requester string) error
+	MigrateStatusContext(ctx context.Context, adapter githubapps.GitHubAppsAdapter, config *model.RepositoryConfig, previousContext string, now time.Time) error
 }
 
 func (u *usecaseImpl) OnDeleteAppFromOwner(ctx context.Context, owner string) error {
@@ -55,6 +81,7 @@ func (u *usecaseImpl) OnDeleteAppFromOwner(ctx context.Context, owner string) er
 func (u *usecaseImpl) OnRemoveRepositories(ctx context.Context, repos []*github.Repository) error {
 	eg, ctx := errgroup.WithContext(ctx)
 	for _, r := range repos {
+		r := r
 		eg.Go(func() error {
 			return u.onRemoveRepository(ctx, r)
 		})
@@ -76,6 +103,7 @@ func (u *usecaseImpl) onRemoveRepository(ctx context.Context, removedRepo *githu
 func (u *usecaseImpl) OnInstallRepositories(ctx context.Context, repos []*github.Repository) error {
 	eg, ctx := errgroup.WithContext(ctx)
 	for _, r := range repos {
+		r := r
 		eg.Go(func() error {
 			return u.onInstallRepository(ctx, r)
 		})
@@ -86,6 +114,8 @@ func (u *usecaseImpl) OnInstallRepositories(ctx context.Context, repos []*github
 	return nil
 }
 
+// onInstallRepository creates the default config of the installed repository.
+// The existing config is kept as it is, and released from the quarantine if the app was uninstalled from the owner and is installed again.
 func (u *usecaseImpl) onInstallRepository(ctx context.Context, installedRepo *github.Repository) error {
 	logger := logging.GetLogger(ctx)
 	logger.Infof("install repository: %#v", installedRepo)
@@ -93,6 +123,21 @@ func (u *usecaseImpl) onInstallRepository(ctx context.Context, installedRepo *gi
 	if len(parts) < 2 {
 		return fmt.Errorf("invalid repo fullName")
 	}
+	current, err := u.repo.GetRepositoryConfig(ctx, parts[0], parts[1])
+	switch {
+	case err == repo.ErrNotFound:
+	case err != nil:
+		return fmt.Errorf("failed to get config: %w", err)
+	case current.Quarantined():
+		logger.Infof("release the config from the quarantine owner=%s repo=%s", current.Owner, current.Name)
+		current.QuarantinedAt = time.Time{}
+		if err := u.repo.UpdateMergeChanceStates(ctx, current); err != nil {
+			return fmt.Errorf("failed to update config: %w", err)
+		}
+		return nil
+	default:
+		return nil
+	}
 	return u.repo.PutRepositoryConfigs(ctx, []*model.RepositoryConfig{
 		{
 			Owner:          parts[0],
@@ -100,82 +145,247 @@ func (u *usecaseImpl) onInstallRepository(ctx context.Context, installedRepo *gi
 			MergeAvailable: true,
 			Schedules: &model.MergeChanceSchedules{
 				Sunday:    nil,
-				Monday:    model.WholeDay,
-				Tuesday:   model.WholeDay,
-				Wednesday: model.WholeDay,
-				Thursday:  model.WholeDay,
-				Friday:    model.WholeDay,
+				Monday:    []*model.MergeChanceSchedule{model.WholeDay},
+				Tuesday:   []*model.MergeChanceSchedule{model.WholeDay},
+				Wednesday: []*model.MergeChanceSchedule{model.WholeDay},
+				Thursday:  []*model.MergeChanceSchedule{model.WholeDay},
+				Friday:    []*model.MergeChanceSchedule{model.WholeDay},
 				Saturday:  nil,
 			},
 		},
 	})
 }
 
-func (u *usecaseImpl) UpdateChanceTime(ctx context.Context, adapter githubapps.GitHubAppsAdapter, baseTime time.Time) error {
+// UpdateChanceTime reconciles the merge chance states of each repository and its branch rules with the states desired by the schedules at baseTime.
+// A failure on a repository does not prevent the others from being updated, and it is reported in the summary.
+// The configs of the owners the app is no longer installed on are quarantined, and deleted once they have been quarantined for orphanRetention.
+// The returned error is reserved for failures affecting every repository.
+//
+// The reconciled states are persisted for each repository after its commit statuses are updated, and the configs failed to update the statuses are not persisted at all,
+// so that they drift again and are retried on the next run. Updating the same statuses again is harmless if persisting the states fails.
+// Only the fields changed by the cron are persisted, and they are not persisted if the config has been updated since it was listed,
+// so that the changes made by users in the meantime are not overwritten. Such configs are reported as failures and reconciled on the next run.
+func (u *usecaseImpl) UpdateChanceTime(ctx context.Context, adapter githubapps.GitHubAppsAdapter, baseTime time.Time) (*UpdateChanceTimeSummary, error) {
 	logger := logging.GetLogger(ctx)
-	installations, _, err := adapter.NewAppClient().Apps().ListInstallations(ctx, nil)
+	installationByOwner, err := listInstallationsByOwner(ctx, adapter)
 	if err != nil {
-		return err
-	}
-	installationByOwner := map[string]*github.Installation{}
-	for _, inst := range installations {
-		owner := inst.GetAccount().GetLogin()
-		installationByOwner[owner] = inst
+		return nil, err
 	}
 
 	configsByOwners, err := u.repo.ListConfigsByOwners(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to list repository config: %w", err)
+		return nil, fmt.Errorf("failed to list repository config: %w", err)
 	}
 
-	toBeUpdated := []*model.RepositoryConfig{}
-	g, c := errgroup.WithContext(ctx)
+	transitions := []*transition{}
 	for _, configs := range configsByOwners {
-		for _, cfg := range configs {
-			config := cfg
-			logger.Infof("owner=%s repo=%s", config.Owner, config.Name)
-			install := installationByOwner[config.Owner]
-			if install == nil {
-				return fmt.Errorf("no installation found on %s", config.Owner)
-			}
-			installClient := adapter.NewInstallationClient(install.GetID())
-			srv, err := service.New()
-			if err != nil {
-				return err
+		for _, config := range configs {
+			if tr := u.planTransition(ctx, config, installationByOwner[config.Owner], baseTime); tr != nil {
+				transitions = append(transitions, tr)
 			}
+		}
+	}
 
-			if config.ShouldStartOn(baseTime) {
-				config.MergeAvailable = true
-				toBeUpdated = append(toBeUpdated, config)
-
-				g.Go(func() error {
-					return updateCommitStatuses(c, installClient, install, config, srv, true)
-				})
+	semaphores := map[int64]chan struct{}{}
+	wg := &sync.WaitGroup{}
+	for _, tr := range transitions {
+		if !tr.reconciled {
+			continue
+		}
+		tr := tr
+		installClient := adapter.NewInstallationClient(tr.install.GetID())
+		sem := u.semaphore(semaphores, tr.install.GetID())
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if sem != nil {
+				sem <- struct{}{}
+				defer func() { <-sem }()
 			}
-			if config.ShouldStopOn(baseTime) {
-				config.MergeAvailable = false
-				toBeUpdated = append(toBeUpdated, config)
+			// each goroutine writes only its own transition, which is read after wg.Wait
+			tr.err = updateCommitStatuses(ctx, installClient, tr.install, tr.config, u.srv, baseTime)
+		}()
+	}
+	wg.Wait()
 
-				g.Go(func() error {
-					return updateCommitStatuses(c, installClient, install, config, srv, false)
-				})
+	for _, tr := range transitions {
+		if tr.err == nil && !tr.deleted {
+			if err := u.repo.UpdateMergeChanceStates(ctx, tr.config); err != nil {
+				tr.err = fmt.Errorf("failed to update config: %w", err)
 			}
 		}
+		if tr.err != nil {
+			logger.Warnf("failed to update owner=%s repo=%s: %+v", tr.config.Owner, tr.config.Name, tr.err)
+		}
 	}
-	if len(toBeUpdated) > 0 {
-		if err := u.repo.PutRepositoryConfigs(c, toBeUpdated); err != nil {
-			return fmt.Errorf("failed to update config: %w", err)
+	events := []*model.AuditEvent{}
+	for _, tr := range transitions {
+		if tr.err == nil {
+			events = append(events, tr.events...)
+		}
+	}
+	if len(events) > 0 {
+		if err := u.repo.AddAuditEvents(ctx, events); err != nil {
+			// the transitions have been made, so they are not retried for the audit log
+			logger.Errorf("failed to record audit events: %+v", err)
+		}
+	}
+
+	summary := newUpdateChanceTimeSummary()
+	for _, tr := range transitions {
+		summary.add(tr)
+	}
+	summary.sort()
+	return summary, nil
+}
+
+// transition is the change of a config made by UpdateChanceTime.
+type transition struct {
+	config  *model.RepositoryConfig
+	install *github.Installation
+	// reconciled reports whether the merge chance states are changed, so that the commit statuses must be updated before the config is persisted.
+	reconciled  bool
+	quarantined bool
+	released    bool
+	deleted     bool
+	// events are recorded in the audit log once the transition is made.
+	events []*model.AuditEvent
+	err    error
+}
+
+// planTransition applies the changes at baseTime to the config, and returns the transition to persist or nil if the config is not changed.
+// The quarantined config is deleted here once it has been quarantined for orphanRetention.
+func (u *usecaseImpl) planTransition(ctx context.Context, config *model.RepositoryConfig, install *github.Installation, baseTime time.Time) *transition {
+	logger := logging.GetLogger(ctx)
+	if install == nil {
+		switch {
+		case !config.Quarantined():
+			logger.Warnf("quarantine the config of the owner without installation owner=%s repo=%s", config.Owner, config.Name)
+			config.QuarantinedAt = baseTime
+			return &transition{config: config, quarantined: true}
+		case !baseTime.Before(config.QuarantinedAt.Add(orphanRetention)):
+			logger.Infof("delete the quarantined config owner=%s repo=%s quarantinedAt=%s", config.Owner, config.Name, config.QuarantinedAt)
+			return &transition{config: config, deleted: true, err: u.repo.DeleteRepositoryConfig(ctx, config.Owner, config.Name)}
+		default:
+			return nil
 		}
 	}
-	if err := g.Wait(); err != nil {
+
+	tr := &transition{config: config, install: install}
+	// the schedules evaluated in another time zone would flip the states at wrong instants
+	if _, err := config.Location(); err != nil {
+		tr.err = err
+		return tr
+	}
+	if config.Quarantined() {
+		logger.Infof("release the config from the quarantine owner=%s repo=%s", config.Owner, config.Name)
+		config.QuarantinedAt = time.Time{}
+		tr.released = true
+	}
+	overrideExpired := config.Override != nil && !config.Override.ActiveAt(baseTime)
+	if overrideExpired {
+		logger.Infof("override expired owner=%s repo=%s until=%s", config.Owner, config.Name, config.Override.Until)
+		tr.events = append(tr.events, config.OverrideExpiredEvent(baseTime))
+		config.Override = nil
+	}
+	transitionEvents := config.ReconcileEvents(baseTime)
+	tr.events = append(tr.events, transitionEvents...)
+	tr.reconciled = len(transitionEvents) > 0
+	if tr.reconciled {
+		logger.Infof("reconciled owner=%s repo=%s mergeAvailable=%v", config.Owner, config.Name, config.MergeAvailable)
+	}
+	if !tr.reconciled && !tr.released && !overrideExpired {
+		return nil
+	}
+	return tr
+}
+
+// semaphore returns the semaphore bounding the concurrency of the installation, or nil if it is not bounded.
+func (u *usecaseImpl) semaphore(semaphores map[int64]chan struct{}, installID int64) chan struct{} {
+	if u.maxConcurrencyPerInstallation <= 0 {
+		return nil
+	}
+	sem, ok := semaphores[installID]
+	if !ok {
+		sem = make(chan struct{}, u.maxConcurrencyPerInstallation)
+		semaphores[installID] = sem
+	}
+	return sem
+}
+
+// ForceMergeWindow sets the override on the repository and updates the commit statuses of open pull requests immediately.
+// The override is cleared by UpdateChanceTime once it expires.
+// It returns an error wrapping repo.ErrConflict if the config has been updated since it was read,
+// and the commit statuses already updated then are reconciled by UpdateChanceTime.
+func (u *usecaseImpl) ForceMergeWindow(ctx context.Context, adapter githubapps.GitHubAppsAdapter, owner, name string, override *model.Override) error {
+	config, err := u.repo.GetRepositoryConfig(ctx, owner, name)
+	if err == repo.ErrNotFound {
+		return ErrConfigNotFound
+	}
+	if err != nil {
+		return err
+	}
+	if !override.ActiveAt(override.CreatedAt) {
+		return fmt.Errorf("%w: override must expire in the future", ErrInvalidInput)
+	}
+
+	installationByOwner, err := listInstallationsByOwner(ctx, adapter)
+	if err != nil {
+		return err
+	}
+	install := installationByOwner[owner]
+	if install == nil {
+		return ErrInstallationNotFound
+	}
+
+	config.Override = override
+	events := append([]*model.AuditEvent{config.OverrideSetEvent(override)}, config.ReconcileEvents(override.CreatedAt)...)
+	if err := updateCommitStatuses(ctx, adapter.NewInstallationClient(install.GetID()), install, config, u.srv, override.CreatedAt); err != nil {
 		return fmt.Errorf("failed to update commit status: %w", err)
 	}
+	if err := u.repo.UpdateMergeChanceStates(ctx, config); err != nil {
+		return fmt.Errorf("failed to update config: %w", err)
+	}
+	if err := u.repo.AddAuditEvents(ctx, events); err != nil {
+		return fmt.Errorf("override set but failed to record the audit log: %w", err)
+	}
+	return nil
+}
+
+// MigrateStatusContext moves the statuses of open pull requests from previousContext to the current context of the config.
+// The statuses of previousContext are made passing, so that they do not block pull requests once branch protection requires the new context.
+func (u *usecaseImpl) MigrateStatusContext(ctx context.Context, adapter githubapps.GitHubAppsAdapter, config *model.RepositoryConfig, previousContext string, now time.Time) error {
+	currentContext := config.StatusContextName()
+	if previousContext == currentContext {
+		return nil
+	}
+	installationByOwner, err := listInstallationsByOwner(ctx, adapter)
+	if err != nil {
+		return err
+	}
+	install := installationByOwner[config.Owner]
+	if install == nil {
+		return ErrInstallationNotFound
+	}
 
+	installClient := adapter.NewInstallationClient(install.GetID())
+	prs, err := githubapi.ListAllPullRequests(ctx, installClient.PullRequests(), config.Owner, config.Name, openPullRequests)
+	if err != nil {
+		return fmt.Errorf("failed to fetch pull requests on %s/%s: %w", config.Owner, config.Name, err)
+	}
+	for _, pr := range prs {
+		if err := reportPullRequest(ctx, u.srv, installClient, config, pr, now); err != nil {
+			return err
+		}
+		if err := u.srv.RetirePullRequest(ctx, installClient, pr, previousContext, currentContext); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
 func (u *usecaseImpl) UpdatePullRequestCommitStatus(ctx context.Context, client githubapi.Client, pr *github.PullRequest) error {
-	targetRepo := pr.GetHead().GetRepo()
+	targetRepo := pr.GetBase().GetRepo()
 	config, err := u.repo.GetRepositoryConfig(ctx, targetRepo.GetOwner().GetLogin(), targetRepo.GetName())
 	if err == repo.ErrNotFound {
 		return ErrConfigNotFound
@@ -187,31 +397,148 @@ func (u *usecaseImpl) UpdatePullRequestCommitStatus(ctx context.Context, client
 		return nil
 	}
 
-	srv, err := service.New()
-	if err != nil {
+	return reportPullRequest(ctx, u.srv, client, config, pr, time.Now())
+}
+
+// RequestOverride records on the check run that the requester asked for an override of the merge chance.
+func (u *usecaseImpl) RequestOverride(ctx context.Context, client githubapi.Client, repo *github.Repository, checkRun *github.CheckRun, requester string) error {
+	logger := logging.GetLogger(ctx)
+	logger.Infof("override requested repo=%s checkRun=%d requester=%s", repo.GetFullName(), checkRun.GetID(), requester)
+	if err := u.srv.AcknowledgeOverrideRequest(ctx, client, repo, checkRun, requester); err != nil {
 		return err
 	}
-	if config.MergeAvailable {
-		return srv.ApprovePullRequest(ctx, client, pr)
+	event := &model.AuditEvent{
+		Owner:       repo.GetOwner().GetLogin(),
+		Name:        repo.GetName(),
+		Type:        model.AuditEventOverrideRequested,
+		Actor:       requester,
+		Description: fmt.Sprintf("requested on %s", checkRun.GetHeadSHA()),
+		CreatedAt:   time.Now(),
 	}
+	return u.repo.AddAuditEvents(ctx, []*model.AuditEvent{event})
+}
 
-	return srv.PendingPullRequest(ctx, client, pr)
+// reportPullRequest reports whether the pull request is mergeable at now, along with the next transition of the merge chance governing it.
+func reportPullRequest(ctx context.Context, srv service.Service, client githubapi.Client, cfg *model.RepositoryConfig, pr *github.PullRequest, now time.Time) error {
+	if _, err := cfg.Location(); err != nil {
+		return err
+	}
+	base := pr.GetBase().GetRef()
+	labels := labelNames(pr)
+	mergeable := cfg.PullRequestMergeable(base, labels)
+	report := &service.Report{Context: cfg.StatusContextName(), Description: cfg.StatusDescription(mergeable), Now: now}
+	if cfg.Gates(base) && !cfg.BypassedBy(labels) {
+		report.NextTransition, _ = cfg.NextTransition(base, now)
+	}
+	if mergeable {
+		return srv.ApprovePullRequest(ctx, client, pr, report)
+	}
+	return srv.PendingPullRequest(ctx, client, pr, report)
+}
+
+func labelNames(pr *github.PullRequest) []string {
+	names := make([]string, len(pr.Labels))
+	for i, label := range pr.Labels {
+		names[i] = label.GetName()
+	}
+	return names
 }
 
-func updateCommitStatuses(ctx context.Context, installClient githubapi.Client, install *github.Installation, cfg *model.RepositoryConfig, srv service.Service, approve bool) error {
-	prs, _, err := installClient.PullRequests().List(ctx, cfg.Owner, cfg.Name, nil)
+// CalendarFeed returns the merge chances of the repository as a calendar.
+// Each window recurs weekly from the week of now, and is excluded on the exception dates.
+// It returns ErrFeedRevoked if generation is not the current feed generation of the config.
+func (u *usecaseImpl) CalendarFeed(ctx context.Context, owner, name string, generation int, now time.Time) (*ical.Calendar, error) {
+	config, err := u.repo.GetRepositoryConfig(ctx, owner, name)
+	if err == repo.ErrNotFound {
+		return nil, ErrConfigNotFound
+	}
 	if err != nil {
-		return fmt.Errorf("failed to fetch pull requests on %s/%s: %w", cfg.Owner, cfg.Name, err)
+		return nil, err
 	}
-	for _, pr := range prs {
-		if approve {
-			if err := srv.ApprovePullRequest(ctx, installClient, pr); err != nil {
-				return err
+	if config.FeedGeneration != generation {
+		return nil, ErrFeedRevoked
+	}
+	loc, err := config.Location()
+	if err != nil {
+		return nil, err
+	}
+	if loc == nil {
+		loc = time.Local
+	}
+
+	exceptionDates := []*model.ExceptionDate{}
+	for _, calendar := range []*model.Calendar{config.OwnerCalendar, config.Calendar} {
+		if calendar != nil {
+			exceptionDates = append(exceptionDates, calendar.ExceptionDates...)
+		}
+	}
+
+	local := now.In(loc)
+	weekStart := time.Date(local.Year(), local.Month(), local.Day()-int(local.Weekday()), 0, 0, 0, 0, loc)
+	calendar := &ical.Calendar{Name: fmt.Sprintf("Merge chances of %s/%s", owner, name), Events: []*ical.Event{}}
+	for wd := time.Sunday; wd <= time.Saturday; wd++ {
+		day := weekStart.AddDate(0, 0, int(wd))
+		for _, schedule := range config.Schedules.ForWeekday(wd) {
+			event := &ical.Event{
+				UID:     fmt.Sprintf("%s.%s.%s.%02d%02d@mergechancetime.app", owner, name, strings.ToLower(wd.String()), schedule.StartHour, schedule.StartMinute),
+				Summary: "Merges open",
+				Start:   schedule.StartOn(day),
+				End:     schedule.StopOn(day),
+				RRule:   "FREQ=WEEKLY",
+				ExDates: []time.Time{},
 			}
-		} else {
-			if err := srv.PendingPullRequest(ctx, installClient, pr); err != nil {
-				return err
+			for _, d := range exceptionDates {
+				date := time.Date(d.Date.Year, d.Date.Month, d.Date.Day, 0, 0, 0, 0, loc)
+				if date.Weekday() == wd {
+					event.ExDates = append(event.ExDates, schedule.StartOn(date))
+				}
 			}
+			calendar.Events = append(calendar.Events, event)
+		}
+	}
+	for _, c := range []*model.Calendar{config.OwnerCalendar, config.Calendar} {
+		if c == nil {
+			continue
+		}
+		for _, period := range c.FreezePeriods {
+			if !period.End.After(now) {
+				continue
+			}
+			calendar.Events = append(calendar.Events, &ical.Event{
+				UID:     fmt.Sprintf("%s.%s.freeze.%d@mergechancetime.app", owner, name, period.Start.Unix()),
+				Summary: strings.TrimSpace("Merges frozen " + period.Summary),
+				Start:   period.Start.In(loc),
+				End:     period.End.In(loc),
+			})
+		}
+	}
+	return calendar, nil
+}
+
+func listInstallationsByOwner(ctx context.Context, adapter githubapps.GitHubAppsAdapter) (map[string]*github.Installation, error) {
+	installations, err := githubapi.ListAllInstallations(ctx, adapter.NewAppClient().Apps())
+	if err != nil {
+		return nil, err
+	}
+	installationByOwner := map[string]*github.Installation{}
+	for _, inst := range installations {
+		owner := inst.GetAccount().GetLogin()
+		installationByOwner[owner] = inst
+	}
+	return installationByOwner, nil
+}
+
+// updateCommitStatuses updates the commit statuses of open pull requests according to the current state of cfg.
+// The pull requests into the branches cfg does not gate are reported as mergeable too,
+// because they may have been reported pending while the former config gated their branches.
+func updateCommitStatuses(ctx context.Context, installClient githubapi.Client, install *github.Installation, cfg *model.RepositoryConfig, srv service.Service, now time.Time) error {
+	prs, err := githubapi.ListAllPullRequests(ctx, installClient.PullRequests(), cfg.Owner, cfg.Name, openPullRequests)
+	if err != nil {
+		return fmt.Errorf("failed to fetch pull requests on %s/%s: %w", cfg.Owner, cfg.Name, err)
+	}
+	for _, pr := range prs {
+		if err := reportPullRequest(ctx, srv, installClient, cfg, pr, now); err != nil {
+			return err
 		}
 	}
 	return nil
@@ -11,6 +11,8 @@ import (
 
 	githubapi "github.com/aereal/merge-chance-time/app/adapter/githubapi"
 	githubapps "github.com/aereal/merge-chance-time/app/adapter/githubapps"
+	model "github.com/aereal/merge-chance-time/domain/model"
+	ical "github.com/aereal/merge-chance-time/ical"
 	gomock "github.com/golang/mock/gomock"
 	github "github.com/google/go-github/v30/github"
 )
@@ -38,6 +40,49 @@ func (m *MockUsecase) EXPECT() *MockUsecaseMockRecorder {
 	return m.recorder
 }
 
+// CalendarFeed mocks base method
+func (m *MockUsecase) CalendarFeed(arg0 context.Context, arg1, arg2 string, arg3 int, arg4 time.Time) (*ical.Calendar, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CalendarFeed", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(*ical.Calendar)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CalendarFeed indicates an expected call of CalendarFeed
+func (mr *MockUsecaseMockRecorder) CalendarFeed(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CalendarFeed", reflect.TypeOf((*MockUsecase)(nil).CalendarFeed), arg0, arg1, arg2, arg3, arg4)
+}
+
+// ForceMergeWindow mocks base method
+func (m *MockUsecase) ForceMergeWindow(arg0 context.Context, arg1 githubapps.GitHubAppsAdapter, arg2, arg3 string, arg4 *model.Override) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ForceMergeWindow", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ForceMergeWindow indicates an expected call of ForceMergeWindow
+func (mr *MockUsecaseMockRecorder) ForceMergeWindow(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForceMergeWindow", reflect.TypeOf((*MockUsecase)(nil).ForceMergeWindow), arg0, arg1, arg2, arg3, arg4)
+}
+
+// MigrateStatusContext mocks base method
+func (m *MockUsecase) MigrateStatusContext(arg0 context.Context, arg1 githubapps.GitHubAppsAdapter, arg2 *model.RepositoryConfig, arg3 string, arg4 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MigrateStatusContext", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MigrateStatusContext indicates an expected call of MigrateStatusContext
+func (mr *MockUsecaseMockRecorder) MigrateStatusContext(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateStatusContext", reflect.TypeOf((*MockUsecase)(nil).MigrateStatusContext), arg0, arg1, arg2, arg3, arg4)
+}
+
 // OnDeleteAppFromOwner mocks base method
 func (m *MockUsecase) OnDeleteAppFromOwner(arg0 context.Context, arg1 string) error {
 	m.ctrl.T.Helper()
@@ -80,14 +125,29 @@ func (mr *MockUsecaseMockRecorder) OnRemoveRepositories(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OnRemoveRepositories", reflect.TypeOf((*MockUsecase)(nil).OnRemoveRepositories), arg0, arg1)
 }
 
-// UpdateChanceTime mocks base method
-func (m *MockUsecase) UpdateChanceTime(arg0 context.Context, arg1 githubapps.GitHubAppsAdapter, arg2 time.Time) error {
+// RequestOverride mocks base method
+func (m *MockUsecase) RequestOverride(arg0 context.Context, arg1 githubapi.Client, arg2 *github.Repository, arg3 *github.CheckRun, arg4 string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateChanceTime", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "RequestOverride", arg0, arg1, arg2, arg3, arg4)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
+// RequestOverride indicates an expected call of RequestOverride
+func (mr *MockUsecaseMockRecorder) RequestOverride(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestOverride", reflect.TypeOf((*MockUsecase)(nil).RequestOverride), arg0, arg1, arg2, arg3, arg4)
+}
+
+// UpdateChanceTime mocks base method
+func (m *MockUsecase) UpdateChanceTime(arg0 context.Context, arg1 githubapps.GitHubAppsAdapter, arg2 time.Time) (*UpdateChanceTimeSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateChanceTime", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*UpdateChanceTimeSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
 // UpdateChanceTime indicates an expected call of UpdateChanceTime
 func (mr *MockUsecaseMockRecorder) UpdateChanceTime(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
@@ -4,10 +4,19 @@ package usecase
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"reflect"
+	"sync"
 	"testing"
+	"time"
 
+	"github.com/aereal/merge-chance-time/app/adapter/githubapi"
+	"github.com/aereal/merge-chance-time/app/adapter/githubapps"
 	"github.com/aereal/merge-chance-time/domain/model"
 	"github.com/aereal/merge-chance-time/domain/repo"
+	"github.com/aereal/merge-chance-time/domain/service"
 	"github.com/aereal/merge-chance-time/logging"
 	"github.com/golang/mock/gomock"
 	"github.com/google/go-github/v30/github"
@@ -31,6 +40,7 @@ func Test_usecaseImpl_onInstallRepository(t *testing.T) {
 			fields: fields{
 				repo: func(ctrl *gomock.Controller) repo.Repository {
 					r := repo.NewMockRepository(ctrl)
+					r.EXPECT().GetRepositoryConfig(gomock.Any(), "aereal", "example-repo").Return(nil, repo.ErrNotFound)
 					r.EXPECT().
 						PutRepositoryConfigs(gomock.Any(), gomock.Eq([]*model.RepositoryConfig{
 							{
@@ -39,11 +49,11 @@ func Test_usecaseImpl_onInstallRepository(t *testing.T) {
 								MergeAvailable: true,
 								Schedules: &model.MergeChanceSchedules{
 									Sunday:    nil,
-									Monday:    model.WholeDay,
-									Tuesday:   model.WholeDay,
-									Wednesday: model.WholeDay,
-									Thursday:  model.WholeDay,
-									Friday:    model.WholeDay,
+									Monday:    []*model.MergeChanceSchedule{model.WholeDay},
+									Tuesday:   []*model.MergeChanceSchedule{model.WholeDay},
+									Wednesday: []*model.MergeChanceSchedule{model.WholeDay},
+									Thursday:  []*model.MergeChanceSchedule{model.WholeDay},
+									Friday:    []*model.MergeChanceSchedule{model.WholeDay},
 									Saturday:  nil,
 								},
 							},
@@ -64,14 +74,78 @@ func Test_usecaseImpl_onInstallRepository(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "existing config",
+			fields: fields{
+				repo: func(ctrl *gomock.Controller) repo.Repository {
+					r := repo.NewMockRepository(ctrl)
+					r.EXPECT().GetRepositoryConfig(gomock.Any(), "aereal", "example-repo").
+						Return(&model.RepositoryConfig{Owner: "aereal", Name: "example-repo", Schedules: &model.MergeChanceSchedules{}}, nil)
+					return r
+				},
+			},
+			args: args{
+				installedRepo: &github.Repository{
+					Name:     github.String("example-repo"),
+					FullName: github.String("aereal/example-repo"),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "quarantined config",
+			fields: fields{
+				repo: func(ctrl *gomock.Controller) repo.Repository {
+					r := repo.NewMockRepository(ctrl)
+					r.EXPECT().GetRepositoryConfig(gomock.Any(), "aereal", "example-repo").
+						Return(&model.RepositoryConfig{
+							Owner:         "aereal",
+							Name:          "example-repo",
+							Schedules:     &model.MergeChanceSchedules{},
+							QuarantinedAt: time.Date(2020, time.February, 3, 12, 0, 0, 0, time.UTC),
+						}, nil)
+					r.EXPECT().
+						UpdateMergeChanceStates(gomock.Any(), gomock.Eq(&model.RepositoryConfig{Owner: "aereal", Name: "example-repo", Schedules: &model.MergeChanceSchedules{}})).
+						Return(nil).
+						Times(1)
+					return r
+				},
+			},
+			args: args{
+				installedRepo: &github.Repository{
+					Name:     github.String("example-repo"),
+					FullName: github.String("aereal/example-repo"),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "failed to get config",
+			fields: fields{
+				repo: func(ctrl *gomock.Controller) repo.Repository {
+					r := repo.NewMockRepository(ctrl)
+					r.EXPECT().GetRepositoryConfig(gomock.Any(), "aereal", "example-repo").Return(nil, fmt.Errorf("unavailable"))
+					return r
+				},
+			},
+			args: args{
+				installedRepo: &github.Repository{
+					Name:     github.String("example-repo"),
+					FullName: github.String("aereal/example-repo"),
+				},
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
+		tt := tt
 		t.Run(tt.name, func(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 
 			u := &usecaseImpl{
 				repo: tt.fields.repo(ctrl),
+				srv:  newService(service.ReportCommitStatus),
 			}
 			ctx := logging.SetNilLogger(context.Background())
 			if err := u.onInstallRepository(ctx, tt.args.installedRepo); (err != nil) != tt.wantErr {
@@ -80,3 +154,1308 @@ func Test_usecaseImpl_onInstallRepository(t *testing.T) {
 		})
 	}
 }
+
+// auditEventsMatcher matches the audit events of the types in order.
+type auditEventsMatcher []model.AuditEventType
+
+func (m auditEventsMatcher) Matches(x interface{}) bool {
+	events, ok := x.([]*model.AuditEvent)
+	if !ok || len(events) != len(m) {
+		return false
+	}
+	for i, event := range events {
+		if event.Type != m[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func (m auditEventsMatcher) String() string {
+	return fmt.Sprintf("is audit events of %v", []model.AuditEventType(m))
+}
+
+func Test_usecaseImpl_UpdateChanceTime(t *testing.T) {
+	baseTime := time.Date(2020, time.February, 3, 12, 0, 0, 0, time.UTC) // Monday
+	schedules := &model.MergeChanceSchedules{
+		Monday: []*model.MergeChanceSchedule{{StartHour: 10, StopHour: 18}},
+	}
+	pr := &github.PullRequest{
+		Number: github.Int(1),
+		Base: &github.PullRequestBranch{
+			Repo: &github.Repository{
+				Name:  github.String("example-repo"),
+				Owner: &github.User{Login: github.String("aereal")},
+			},
+		},
+		// the head is in a fork, and the merge chance is reported on the base repository
+		Head: &github.PullRequestBranch{
+			SHA: github.String("0xdeadbeaf"),
+			Repo: &github.Repository{
+				Name:  github.String("example-repo"),
+				Owner: &github.User{Login: github.String("forker")},
+			},
+		},
+	}
+	installations := []*github.Installation{
+		{ID: github.Int64(1234), Account: &github.User{Login: github.String("aereal")}},
+	}
+
+	tests := []struct {
+		name      string
+		repo      func(ctrl *gomock.Controller) repo.Repository
+		ghAdapter func(ctrl *gomock.Controller) githubapps.GitHubAppsAdapter
+		want      *UpdateChanceTimeSummary
+		wantErr   bool
+	}{
+		{
+			name: "drifted",
+			repo: func(ctrl *gomock.Controller) repo.Repository {
+				r := repo.NewMockRepository(ctrl)
+				r.EXPECT().ListConfigsByOwners(gomock.Any()).Return(map[string][]*model.RepositoryConfig{
+					"aereal": {{Owner: "aereal", Name: "example-repo", Schedules: schedules, MergeAvailable: false}},
+				}, nil)
+				r.EXPECT().UpdateMergeChanceStates(gomock.Any(), gomock.Eq(&model.RepositoryConfig{Owner: "aereal", Name: "example-repo", Schedules: schedules, MergeAvailable: true})).Return(nil).Times(1)
+				r.EXPECT().AddAuditEvents(gomock.Any(), auditEventsMatcher{model.AuditEventMergeOpened}).Return(nil).Times(1)
+				return r
+			},
+			ghAdapter: func(ctrl *gomock.Controller) githubapps.GitHubAppsAdapter {
+				apps := githubapi.NewMockAppsService(ctrl)
+				apps.EXPECT().ListInstallations(gomock.Any(), gomock.Any()).Return(installations, nil, nil)
+				appClient := githubapi.NewMockClient(ctrl)
+				appClient.EXPECT().Apps().Return(apps)
+
+				prs := githubapi.NewMockPullRequestService(ctrl)
+				prs.EXPECT().List(gomock.Any(), "aereal", "example-repo", gomock.Any()).Return([]*github.PullRequest{pr}, nil, nil)
+				repos := githubapi.NewMockRepositoriesService(ctrl)
+				repos.EXPECT().
+					CreateStatus(gomock.Any(), "aereal", "example-repo", "0xdeadbeaf", statusMatcher{state: "success", description: "open until Mon 18:00 UTC"}).
+					Return(nil, nil, nil).
+					Times(1)
+				installClient := githubapi.NewMockClient(ctrl)
+				installClient.EXPECT().PullRequests().Return(prs)
+				installClient.EXPECT().Repositories().Return(repos)
+
+				a := githubapps.NewMockGitHubAppsAdapter(ctrl)
+				a.EXPECT().NewAppClient().Return(appClient)
+				a.EXPECT().NewInstallationClient(int64(1234)).Return(installClient)
+				return a
+			},
+			want:    &UpdateChanceTimeSummary{Updated: []string{"aereal/example-repo"}, Quarantined: []string{}, Released: []string{}, Deleted: []string{}, Failures: []*RepositoryFailure{}},
+			wantErr: false,
+		},
+		{
+			name: "in desired state",
+			repo: func(ctrl *gomock.Controller) repo.Repository {
+				r := repo.NewMockRepository(ctrl)
+				r.EXPECT().ListConfigsByOwners(gomock.Any()).Return(map[string][]*model.RepositoryConfig{
+					"aereal": {{Owner: "aereal", Name: "example-repo", Schedules: schedules, MergeAvailable: true}},
+				}, nil)
+				return r
+			},
+			ghAdapter: func(ctrl *gomock.Controller) githubapps.GitHubAppsAdapter {
+				apps := githubapi.NewMockAppsService(ctrl)
+				apps.EXPECT().ListInstallations(gomock.Any(), gomock.Any()).Return(installations, nil, nil)
+				appClient := githubapi.NewMockClient(ctrl)
+				appClient.EXPECT().Apps().Return(apps)
+
+				a := githubapps.NewMockGitHubAppsAdapter(ctrl)
+				a.EXPECT().NewAppClient().Return(appClient)
+				return a
+			},
+			wantErr: false,
+		},
+		{
+			name: "branch rule drifted",
+			repo: func(ctrl *gomock.Controller) repo.Repository {
+				r := repo.NewMockRepository(ctrl)
+				r.EXPECT().ListConfigsByOwners(gomock.Any()).Return(map[string][]*model.RepositoryConfig{
+					"aereal": {{Owner: "aereal", Name: "example-repo", Schedules: schedules, MergeAvailable: true, BranchRules: []*model.BranchRule{
+						{Pattern: "release/*", Schedules: &model.MergeChanceSchedules{}, MergeAvailable: true},
+					}}},
+				}, nil)
+				r.EXPECT().UpdateMergeChanceStates(gomock.Any(), gomock.Eq(&model.RepositoryConfig{Owner: "aereal", Name: "example-repo", Schedules: schedules, MergeAvailable: true, BranchRules: []*model.BranchRule{
+					{Pattern: "release/*", Schedules: &model.MergeChanceSchedules{}, MergeAvailable: false},
+				}})).Return(nil).Times(1)
+				r.EXPECT().AddAuditEvents(gomock.Any(), auditEventsMatcher{model.AuditEventMergeClosed}).Return(nil).Times(1)
+				return r
+			},
+			ghAdapter: func(ctrl *gomock.Controller) githubapps.GitHubAppsAdapter {
+				apps := githubapi.NewMockAppsService(ctrl)
+				apps.EXPECT().ListInstallations(gomock.Any(), gomock.Any()).Return(installations, nil, nil)
+				appClient := githubapi.NewMockClient(ctrl)
+				appClient.EXPECT().Apps().Return(apps)
+
+				releasePR := &github.PullRequest{
+					Number: github.Int(2),
+					Base:   &github.PullRequestBranch{Ref: github.String("release/1.0"), Repo: pr.GetBase().GetRepo()},
+					Head: &github.PullRequestBranch{
+						SHA:  github.String("0xcafebabe"),
+						Repo: pr.GetHead().GetRepo(),
+					},
+				}
+				prs := githubapi.NewMockPullRequestService(ctrl)
+				prs.EXPECT().List(gomock.Any(), "aereal", "example-repo", gomock.Any()).Return([]*github.PullRequest{pr, releasePR}, nil, nil)
+				repos := githubapi.NewMockRepositoriesService(ctrl)
+				repos.EXPECT().
+					CreateStatus(gomock.Any(), "aereal", "example-repo", "0xdeadbeaf", statusStateMatcher("success")).
+					Return(nil, nil, nil).
+					Times(1)
+				repos.EXPECT().
+					CreateStatus(gomock.Any(), "aereal", "example-repo", "0xcafebabe", statusStateMatcher("pending")).
+					Return(nil, nil, nil).
+					Times(1)
+				installClient := githubapi.NewMockClient(ctrl)
+				installClient.EXPECT().PullRequests().Return(prs)
+				installClient.EXPECT().Repositories().Return(repos).Times(2)
+
+				a := githubapps.NewMockGitHubAppsAdapter(ctrl)
+				a.EXPECT().NewAppClient().Return(appClient)
+				a.EXPECT().NewInstallationClient(int64(1234)).Return(installClient)
+				return a
+			},
+			wantErr: false,
+		},
+		{
+			name: "drifted with ungated pull request",
+			repo: func(ctrl *gomock.Controller) repo.Repository {
+				r := repo.NewMockRepository(ctrl)
+				r.EXPECT().ListConfigsByOwners(gomock.Any()).Return(map[string][]*model.RepositoryConfig{
+					"aereal": {{Owner: "aereal", Name: "example-repo", Schedules: &model.MergeChanceSchedules{}, MergeAvailable: true, BaseBranchPatterns: []string{"main"}}},
+				}, nil)
+				r.EXPECT().UpdateMergeChanceStates(gomock.Any(), gomock.Eq(&model.RepositoryConfig{Owner: "aereal", Name: "example-repo", Schedules: &model.MergeChanceSchedules{}, MergeAvailable: false, BaseBranchPatterns: []string{"main"}})).Return(nil).Times(1)
+				r.EXPECT().AddAuditEvents(gomock.Any(), auditEventsMatcher{model.AuditEventMergeClosed}).Return(nil).Times(1)
+				return r
+			},
+			ghAdapter: func(ctrl *gomock.Controller) githubapps.GitHubAppsAdapter {
+				apps := githubapi.NewMockAppsService(ctrl)
+				apps.EXPECT().ListInstallations(gomock.Any(), gomock.Any()).Return(installations, nil, nil)
+				appClient := githubapi.NewMockClient(ctrl)
+				appClient.EXPECT().Apps().Return(apps)
+
+				mainPR := &github.PullRequest{
+					Number: github.Int(2),
+					Base:   &github.PullRequestBranch{Ref: github.String("main"), Repo: pr.GetBase().GetRepo()},
+					Head: &github.PullRequestBranch{
+						SHA:  github.String("0xcafebabe"),
+						Repo: pr.GetHead().GetRepo(),
+					},
+				}
+				prs := githubapi.NewMockPullRequestService(ctrl)
+				prs.EXPECT().List(gomock.Any(), "aereal", "example-repo", gomock.Any()).Return([]*github.PullRequest{pr, mainPR}, nil, nil)
+				repos := githubapi.NewMockRepositoriesService(ctrl)
+				// the pull request into the ungated branch is reported in case it was gated by the former config
+				repos.EXPECT().
+					CreateStatus(gomock.Any(), "aereal", "example-repo", "0xdeadbeaf", statusStateMatcher("success")).
+					Return(nil, nil, nil).
+					Times(1)
+				repos.EXPECT().
+					CreateStatus(gomock.Any(), "aereal", "example-repo", "0xcafebabe", statusStateMatcher("pending")).
+					Return(nil, nil, nil).
+					Times(1)
+				installClient := githubapi.NewMockClient(ctrl)
+				installClient.EXPECT().PullRequests().Return(prs)
+				installClient.EXPECT().Repositories().Return(repos).Times(2)
+
+				a := githubapps.NewMockGitHubAppsAdapter(ctrl)
+				a.EXPECT().NewAppClient().Return(appClient)
+				a.EXPECT().NewInstallationClient(int64(1234)).Return(installClient)
+				return a
+			},
+			wantErr: false,
+		},
+		{
+			name: "override in effect",
+			repo: func(ctrl *gomock.Controller) repo.Repository {
+				r := repo.NewMockRepository(ctrl)
+				r.EXPECT().ListConfigsByOwners(gomock.Any()).Return(map[string][]*model.RepositoryConfig{
+					"aereal": {{Owner: "aereal", Name: "example-repo", Schedules: schedules, MergeAvailable: false, Override: &model.Override{MergeAvailable: false, Until: baseTime.Add(time.Hour)}}},
+				}, nil)
+				return r
+			},
+			ghAdapter: func(ctrl *gomock.Controller) githubapps.GitHubAppsAdapter {
+				apps := githubapi.NewMockAppsService(ctrl)
+				apps.EXPECT().ListInstallations(gomock.Any(), gomock.Any()).Return(installations, nil, nil)
+				appClient := githubapi.NewMockClient(ctrl)
+				appClient.EXPECT().Apps().Return(apps)
+
+				a := githubapps.NewMockGitHubAppsAdapter(ctrl)
+				a.EXPECT().NewAppClient().Return(appClient)
+				return a
+			},
+			wantErr: false,
+		},
+		{
+			name: "override expired",
+			repo: func(ctrl *gomock.Controller) repo.Repository {
+				r := repo.NewMockRepository(ctrl)
+				r.EXPECT().ListConfigsByOwners(gomock.Any()).Return(map[string][]*model.RepositoryConfig{
+					"aereal": {{Owner: "aereal", Name: "example-repo", Schedules: schedules, MergeAvailable: false, Override: &model.Override{MergeAvailable: false, Until: baseTime}}},
+				}, nil)
+				r.EXPECT().UpdateMergeChanceStates(gomock.Any(), gomock.Eq(&model.RepositoryConfig{Owner: "aereal", Name: "example-repo", Schedules: schedules, MergeAvailable: true})).Return(nil).Times(1)
+				r.EXPECT().AddAuditEvents(gomock.Any(), auditEventsMatcher{model.AuditEventOverrideExpired, model.AuditEventMergeOpened}).Return(nil).Times(1)
+				return r
+			},
+			ghAdapter: func(ctrl *gomock.Controller) githubapps.GitHubAppsAdapter {
+				apps := githubapi.NewMockAppsService(ctrl)
+				apps.EXPECT().ListInstallations(gomock.Any(), gomock.Any()).Return(installations, nil, nil)
+				appClient := githubapi.NewMockClient(ctrl)
+				appClient.EXPECT().Apps().Return(apps)
+
+				prs := githubapi.NewMockPullRequestService(ctrl)
+				prs.EXPECT().List(gomock.Any(), "aereal", "example-repo", gomock.Any()).Return([]*github.PullRequest{pr}, nil, nil)
+				repos := githubapi.NewMockRepositoriesService(ctrl)
+				repos.EXPECT().
+					CreateStatus(gomock.Any(), "aereal", "example-repo", "0xdeadbeaf", statusStateMatcher("success")).
+					Return(nil, nil, nil).
+					Times(1)
+				installClient := githubapi.NewMockClient(ctrl)
+				installClient.EXPECT().PullRequests().Return(prs)
+				installClient.EXPECT().Repositories().Return(repos)
+
+				a := githubapps.NewMockGitHubAppsAdapter(ctrl)
+				a.EXPECT().NewAppClient().Return(appClient)
+				a.EXPECT().NewInstallationClient(int64(1234)).Return(installClient)
+				return a
+			},
+			wantErr: false,
+		},
+		{
+			name: "override expired in desired state",
+			repo: func(ctrl *gomock.Controller) repo.Repository {
+				r := repo.NewMockRepository(ctrl)
+				r.EXPECT().ListConfigsByOwners(gomock.Any()).Return(map[string][]*model.RepositoryConfig{
+					"aereal": {{Owner: "aereal", Name: "example-repo", Schedules: schedules, MergeAvailable: true, Override: &model.Override{MergeAvailable: true, Until: baseTime.Add(-time.Hour)}}},
+				}, nil)
+				r.EXPECT().UpdateMergeChanceStates(gomock.Any(), gomock.Eq(&model.RepositoryConfig{Owner: "aereal", Name: "example-repo", Schedules: schedules, MergeAvailable: true})).Return(nil).Times(1)
+				r.EXPECT().AddAuditEvents(gomock.Any(), auditEventsMatcher{model.AuditEventOverrideExpired}).Return(nil).Times(1)
+				return r
+			},
+			ghAdapter: func(ctrl *gomock.Controller) githubapps.GitHubAppsAdapter {
+				apps := githubapi.NewMockAppsService(ctrl)
+				apps.EXPECT().ListInstallations(gomock.Any(), gomock.Any()).Return(installations, nil, nil)
+				appClient := githubapi.NewMockClient(ctrl)
+				appClient.EXPECT().Apps().Return(apps)
+
+				a := githubapps.NewMockGitHubAppsAdapter(ctrl)
+				a.EXPECT().NewAppClient().Return(appClient)
+				return a
+			},
+			wantErr: false,
+		},
+		{
+			name: "failed on a repository",
+			repo: func(ctrl *gomock.Controller) repo.Repository {
+				r := repo.NewMockRepository(ctrl)
+				r.EXPECT().ListConfigsByOwners(gomock.Any()).Return(map[string][]*model.RepositoryConfig{
+					"aereal": {
+						{Owner: "aereal", Name: "example-repo", Schedules: schedules, MergeAvailable: false},
+						{Owner: "aereal", Name: "broken-repo", Schedules: schedules, MergeAvailable: false},
+					},
+				}, nil)
+				r.EXPECT().UpdateMergeChanceStates(gomock.Any(), gomock.Eq(&model.RepositoryConfig{Owner: "aereal", Name: "example-repo", Schedules: schedules, MergeAvailable: true})).Return(nil).Times(1)
+				r.EXPECT().AddAuditEvents(gomock.Any(), auditEventsMatcher{model.AuditEventMergeOpened}).Return(nil).Times(1)
+				return r
+			},
+			ghAdapter: func(ctrl *gomock.Controller) githubapps.GitHubAppsAdapter {
+				apps := githubapi.NewMockAppsService(ctrl)
+				apps.EXPECT().ListInstallations(gomock.Any(), gomock.Any()).Return(installations, nil, nil)
+				appClient := githubapi.NewMockClient(ctrl)
+				appClient.EXPECT().Apps().Return(apps)
+
+				prs := githubapi.NewMockPullRequestService(ctrl)
+				prs.EXPECT().List(gomock.Any(), "aereal", "example-repo", gomock.Any()).Return([]*github.PullRequest{pr}, nil, nil)
+				prs.EXPECT().List(gomock.Any(), "aereal", "broken-repo", gomock.Any()).Return(nil, nil, fmt.Errorf("oops"))
+				repos := githubapi.NewMockRepositoriesService(ctrl)
+				repos.EXPECT().
+					CreateStatus(gomock.Any(), "aereal", "example-repo", "0xdeadbeaf", statusStateMatcher("success")).
+					Return(nil, nil, nil).
+					Times(1)
+				installClient := githubapi.NewMockClient(ctrl)
+				installClient.EXPECT().PullRequests().Return(prs).Times(2)
+				installClient.EXPECT().Repositories().Return(repos)
+
+				a := githubapps.NewMockGitHubAppsAdapter(ctrl)
+				a.EXPECT().NewAppClient().Return(appClient)
+				a.EXPECT().NewInstallationClient(int64(1234)).Return(installClient).Times(2)
+				return a
+			},
+			want: &UpdateChanceTimeSummary{
+				Updated:     []string{"aereal/example-repo"},
+				Quarantined: []string{},
+				Released:    []string{},
+				Deleted:     []string{},
+				Failures:    []*RepositoryFailure{{Repository: "aereal/broken-repo", Error: "failed to fetch pull requests on aereal/broken-repo: oops"}},
+			},
+			wantErr: false,
+		},
+		{
+			name: "updated since listed",
+			repo: func(ctrl *gomock.Controller) repo.Repository {
+				r := repo.NewMockRepository(ctrl)
+				r.EXPECT().ListConfigsByOwners(gomock.Any()).Return(map[string][]*model.RepositoryConfig{
+					"aereal": {
+						{Owner: "aereal", Name: "example-repo", Schedules: schedules, MergeAvailable: false},
+						{Owner: "aereal", Name: "edited-repo", Schedules: schedules, MergeAvailable: false},
+					},
+				}, nil)
+				r.EXPECT().UpdateMergeChanceStates(gomock.Any(), gomock.Eq(&model.RepositoryConfig{Owner: "aereal", Name: "example-repo", Schedules: schedules, MergeAvailable: true})).Return(nil).Times(1)
+				r.EXPECT().UpdateMergeChanceStates(gomock.Any(), gomock.Eq(&model.RepositoryConfig{Owner: "aereal", Name: "edited-repo", Schedules: schedules, MergeAvailable: true})).Return(repo.ErrConflict).Times(1)
+				r.EXPECT().AddAuditEvents(gomock.Any(), auditEventsMatcher{model.AuditEventMergeOpened}).Return(nil).Times(1)
+				return r
+			},
+			ghAdapter: func(ctrl *gomock.Controller) githubapps.GitHubAppsAdapter {
+				apps := githubapi.NewMockAppsService(ctrl)
+				apps.EXPECT().ListInstallations(gomock.Any(), gomock.Any()).Return(installations, nil, nil)
+				appClient := githubapi.NewMockClient(ctrl)
+				appClient.EXPECT().Apps().Return(apps)
+
+				prs := githubapi.NewMockPullRequestService(ctrl)
+				prs.EXPECT().List(gomock.Any(), "aereal", gomock.Any(), gomock.Any()).Return([]*github.PullRequest{}, nil, nil).Times(2)
+				installClient := githubapi.NewMockClient(ctrl)
+				installClient.EXPECT().PullRequests().Return(prs).Times(2)
+
+				a := githubapps.NewMockGitHubAppsAdapter(ctrl)
+				a.EXPECT().NewAppClient().Return(appClient)
+				a.EXPECT().NewInstallationClient(int64(1234)).Return(installClient).Times(2)
+				return a
+			},
+			want: &UpdateChanceTimeSummary{
+				Updated:     []string{"aereal/example-repo"},
+				Quarantined: []string{},
+				Released:    []string{},
+				Deleted:     []string{},
+				Failures:    []*RepositoryFailure{{Repository: "aereal/edited-repo", Error: "failed to update config: updated since it was read"}},
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid time zone",
+			repo: func(ctrl *gomock.Controller) repo.Repository {
+				r := repo.NewMockRepository(ctrl)
+				r.EXPECT().ListConfigsByOwners(gomock.Any()).Return(map[string][]*model.RepositoryConfig{
+					"aereal": {{Owner: "aereal", Name: "example-repo", Schedules: schedules, MergeAvailable: false, TimeZone: "Mars/Olympus_Mons"}},
+				}, nil)
+				return r
+			},
+			ghAdapter: func(ctrl *gomock.Controller) githubapps.GitHubAppsAdapter {
+				apps := githubapi.NewMockAppsService(ctrl)
+				apps.EXPECT().ListInstallations(gomock.Any(), gomock.Any()).Return(installations, nil, nil)
+				appClient := githubapi.NewMockClient(ctrl)
+				appClient.EXPECT().Apps().Return(apps)
+
+				a := githubapps.NewMockGitHubAppsAdapter(ctrl)
+				a.EXPECT().NewAppClient().Return(appClient)
+				return a
+			},
+			want: &UpdateChanceTimeSummary{
+				Updated:     []string{},
+				Quarantined: []string{},
+				Released:    []string{},
+				Deleted:     []string{},
+				Failures:    []*RepositoryFailure{{Repository: "aereal/example-repo", Error: `invalid time zone "Mars/Olympus_Mons": unknown time zone Mars/Olympus_Mons`}},
+			},
+			wantErr: false,
+		},
+		{
+			name: "no installation",
+			repo: func(ctrl *gomock.Controller) repo.Repository {
+				r := repo.NewMockRepository(ctrl)
+				r.EXPECT().ListConfigsByOwners(gomock.Any()).Return(map[string][]*model.RepositoryConfig{
+					"aereal": {{Owner: "aereal", Name: "example-repo", Schedules: schedules, MergeAvailable: true}},
+					"orphan": {{Owner: "orphan", Name: "example-repo", Schedules: schedules, MergeAvailable: false}},
+				}, nil)
+				r.EXPECT().UpdateMergeChanceStates(gomock.Any(), gomock.Eq(&model.RepositoryConfig{Owner: "orphan", Name: "example-repo", Schedules: schedules, MergeAvailable: false, QuarantinedAt: baseTime})).Return(nil).Times(1)
+				return r
+			},
+			ghAdapter: func(ctrl *gomock.Controller) githubapps.GitHubAppsAdapter {
+				apps := githubapi.NewMockAppsService(ctrl)
+				apps.EXPECT().ListInstallations(gomock.Any(), gomock.Any()).Return(installations, nil, nil)
+				appClient := githubapi.NewMockClient(ctrl)
+				appClient.EXPECT().Apps().Return(apps)
+
+				a := githubapps.NewMockGitHubAppsAdapter(ctrl)
+				a.EXPECT().NewAppClient().Return(appClient)
+				return a
+			},
+			want:    &UpdateChanceTimeSummary{Updated: []string{}, Quarantined: []string{"orphan/example-repo"}, Released: []string{}, Deleted: []string{}, Failures: []*RepositoryFailure{}},
+			wantErr: false,
+		},
+		{
+			name: "quarantined",
+			repo: func(ctrl *gomock.Controller) repo.Repository {
+				r := repo.NewMockRepository(ctrl)
+				r.EXPECT().ListConfigsByOwners(gomock.Any()).Return(map[string][]*model.RepositoryConfig{
+					"orphan": {{Owner: "orphan", Name: "example-repo", Schedules: schedules, MergeAvailable: false, QuarantinedAt: baseTime.Add(-time.Hour)}},
+				}, nil)
+				return r
+			},
+			ghAdapter: func(ctrl *gomock.Controller) githubapps.GitHubAppsAdapter {
+				apps := githubapi.NewMockAppsService(ctrl)
+				apps.EXPECT().ListInstallations(gomock.Any(), gomock.Any()).Return(installations, nil, nil)
+				appClient := githubapi.NewMockClient(ctrl)
+				appClient.EXPECT().Apps().Return(apps)
+
+				a := githubapps.NewMockGitHubAppsAdapter(ctrl)
+				a.EXPECT().NewAppClient().Return(appClient)
+				return a
+			},
+			want:    &UpdateChanceTimeSummary{Updated: []string{}, Quarantined: []string{}, Released: []string{}, Deleted: []string{}, Failures: []*RepositoryFailure{}},
+			wantErr: false,
+		},
+		{
+			name: "quarantined longer than retention",
+			repo: func(ctrl *gomock.Controller) repo.Repository {
+				r := repo.NewMockRepository(ctrl)
+				r.EXPECT().ListConfigsByOwners(gomock.Any()).Return(map[string][]*model.RepositoryConfig{
+					"orphan": {{Owner: "orphan", Name: "example-repo", Schedules: schedules, MergeAvailable: false, QuarantinedAt: baseTime.Add(-orphanRetention)}},
+				}, nil)
+				r.EXPECT().DeleteRepositoryConfig(gomock.Any(), "orphan", "example-repo").Return(nil).Times(1)
+				return r
+			},
+			ghAdapter: func(ctrl *gomock.Controller) githubapps.GitHubAppsAdapter {
+				apps := githubapi.NewMockAppsService(ctrl)
+				apps.EXPECT().ListInstallations(gomock.Any(), gomock.Any()).Return(installations, nil, nil)
+				appClient := githubapi.NewMockClient(ctrl)
+				appClient.EXPECT().Apps().Return(apps)
+
+				a := githubapps.NewMockGitHubAppsAdapter(ctrl)
+				a.EXPECT().NewAppClient().Return(appClient)
+				return a
+			},
+			want:    &UpdateChanceTimeSummary{Updated: []string{}, Quarantined: []string{}, Released: []string{}, Deleted: []string{"orphan/example-repo"}, Failures: []*RepositoryFailure{}},
+			wantErr: false,
+		},
+		{
+			name: "installed again",
+			repo: func(ctrl *gomock.Controller) repo.Repository {
+				r := repo.NewMockRepository(ctrl)
+				r.EXPECT().ListConfigsByOwners(gomock.Any()).Return(map[string][]*model.RepositoryConfig{
+					"aereal": {{Owner: "aereal", Name: "example-repo", Schedules: schedules, MergeAvailable: true, QuarantinedAt: baseTime.Add(-time.Hour)}},
+				}, nil)
+				r.EXPECT().UpdateMergeChanceStates(gomock.Any(), gomock.Eq(&model.RepositoryConfig{Owner: "aereal", Name: "example-repo", Schedules: schedules, MergeAvailable: true})).Return(nil).Times(1)
+				return r
+			},
+			ghAdapter: func(ctrl *gomock.Controller) githubapps.GitHubAppsAdapter {
+				apps := githubapi.NewMockAppsService(ctrl)
+				apps.EXPECT().ListInstallations(gomock.Any(), gomock.Any()).Return(installations, nil, nil)
+				appClient := githubapi.NewMockClient(ctrl)
+				appClient.EXPECT().Apps().Return(apps)
+
+				a := githubapps.NewMockGitHubAppsAdapter(ctrl)
+				a.EXPECT().NewAppClient().Return(appClient)
+				return a
+			},
+			want:    &UpdateChanceTimeSummary{Updated: []string{}, Quarantined: []string{}, Released: []string{"aereal/example-repo"}, Deleted: []string{}, Failures: []*RepositoryFailure{}},
+			wantErr: false,
+		},
+		{
+			name: "failed to list installations",
+			repo: func(ctrl *gomock.Controller) repo.Repository {
+				return repo.NewMockRepository(ctrl)
+			},
+			ghAdapter: func(ctrl *gomock.Controller) githubapps.GitHubAppsAdapter {
+				apps := githubapi.NewMockAppsService(ctrl)
+				apps.EXPECT().ListInstallations(gomock.Any(), gomock.Any()).Return(nil, nil, fmt.Errorf("oops"))
+				appClient := githubapi.NewMockClient(ctrl)
+				appClient.EXPECT().Apps().Return(apps)
+
+				a := githubapps.NewMockGitHubAppsAdapter(ctrl)
+				a.EXPECT().NewAppClient().Return(appClient)
+				return a
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			u := &usecaseImpl{
+				repo: tt.repo(ctrl),
+				srv:  newService(service.ReportCommitStatus),
+			}
+			ctx := logging.SetNilLogger(context.Background())
+			got, err := u.UpdateChanceTime(ctx, tt.ghAdapter(ctrl), baseTime)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("usecaseImpl.UpdateChanceTime() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.want != nil {
+				assertSummary(t, got, tt.want)
+			}
+		})
+	}
+}
+
+func assertSummary(t *testing.T, got, want *UpdateChanceTimeSummary) {
+	t.Helper()
+	if !reflect.DeepEqual(got.Updated, want.Updated) {
+		t.Errorf("Updated = %v, want %v", got.Updated, want.Updated)
+	}
+	if !reflect.DeepEqual(got.Quarantined, want.Quarantined) {
+		t.Errorf("Quarantined = %v, want %v", got.Quarantined, want.Quarantined)
+	}
+	if !reflect.DeepEqual(got.Released, want.Released) {
+		t.Errorf("Released = %v, want %v", got.Released, want.Released)
+	}
+	if !reflect.DeepEqual(got.Deleted, want.Deleted) {
+		t.Errorf("Deleted = %v, want %v", got.Deleted, want.Deleted)
+	}
+	if !reflect.DeepEqual(got.Failures, want.Failures) {
+		t.Errorf("Failures = %v, want %v", got.Failures, want.Failures)
+	}
+}
+
+func Test_usecaseImpl_UpdateChanceTime_concurrency(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	baseTime := time.Date(2020, time.February, 3, 12, 0, 0, 0, time.UTC) // Monday
+	schedules := &model.MergeChanceSchedules{
+		Monday: []*model.MergeChanceSchedule{{StartHour: 10, StopHour: 18}},
+	}
+	configs := []*model.RepositoryConfig{}
+	for i := 0; i < 8; i++ {
+		configs = append(configs, &model.RepositoryConfig{Owner: "aereal", Name: fmt.Sprintf("repo-%d", i), Schedules: schedules, MergeAvailable: false})
+	}
+	r := repo.NewMockRepository(ctrl)
+	r.EXPECT().ListConfigsByOwners(gomock.Any()).Return(map[string][]*model.RepositoryConfig{"aereal": configs}, nil)
+	r.EXPECT().UpdateMergeChanceStates(gomock.Any(), gomock.Any()).Return(nil).Times(len(configs))
+	r.EXPECT().AddAuditEvents(gomock.Any(), gomock.Len(8)).Return(nil)
+
+	apps := githubapi.NewMockAppsService(ctrl)
+	apps.EXPECT().ListInstallations(gomock.Any(), gomock.Any()).Return([]*github.Installation{
+		{ID: github.Int64(1234), Account: &github.User{Login: github.String("aereal")}},
+	}, nil, nil)
+	appClient := githubapi.NewMockClient(ctrl)
+	appClient.EXPECT().Apps().Return(apps)
+
+	var (
+		mux               sync.Mutex
+		inFlight, maxSeen int
+	)
+	prs := githubapi.NewMockPullRequestService(ctrl)
+	prs.EXPECT().List(gomock.Any(), "aereal", gomock.Any(), gomock.Any()).Times(len(configs)).
+		DoAndReturn(func(ctx context.Context, owner, name string, opts *github.PullRequestListOptions) ([]*github.PullRequest, *github.Response, error) {
+			mux.Lock()
+			inFlight++
+			if inFlight > maxSeen {
+				maxSeen = inFlight
+			}
+			mux.Unlock()
+			time.Sleep(10 * time.Millisecond)
+			mux.Lock()
+			inFlight--
+			mux.Unlock()
+			return []*github.PullRequest{}, nil, nil
+		})
+	installClient := githubapi.NewMockClient(ctrl)
+	installClient.EXPECT().PullRequests().Return(prs).AnyTimes()
+	adapter := githubapps.NewMockGitHubAppsAdapter(ctrl)
+	adapter.EXPECT().NewAppClient().Return(appClient)
+	adapter.EXPECT().NewInstallationClient(int64(1234)).Return(installClient).AnyTimes()
+
+	u := &usecaseImpl{repo: r, srv: newService(service.ReportCommitStatus), maxConcurrencyPerInstallation: 2}
+	ctx := logging.SetNilLogger(context.Background())
+	if _, err := u.UpdateChanceTime(ctx, adapter, baseTime); err != nil {
+		t.Fatal(err)
+	}
+	if maxSeen > 2 {
+		t.Errorf("max concurrency = %d, want <= 2", maxSeen)
+	}
+}
+
+func Test_usecaseImpl_UpdateChanceTime_race(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	baseTime := time.Date(2020, time.February, 3, 12, 0, 0, 0, time.UTC) // Monday
+	schedules := &model.MergeChanceSchedules{
+		Monday: []*model.MergeChanceSchedule{{StartHour: 10, StopHour: 18}},
+	}
+	owners := []string{"aereal", "octocat"}
+	configsByOwners := map[string][]*model.RepositoryConfig{}
+	installations := []*github.Installation{}
+	for i, owner := range owners {
+		installations = append(installations, &github.Installation{ID: github.Int64(int64(i + 1)), Account: &github.User{Login: github.String(owner)}})
+		for j := 0; j < 10; j++ {
+			configsByOwners[owner] = append(configsByOwners[owner], &model.RepositoryConfig{Owner: owner, Name: fmt.Sprintf("repo-%d", j), Schedules: schedules, MergeAvailable: false})
+		}
+	}
+	// odd repositories fail to update their statuses
+	failing := func(name string) bool {
+		var n int
+		fmt.Sscanf(name, "repo-%d", &n)
+		return n%2 == 1
+	}
+
+	var persisted []*model.RepositoryConfig
+	r := repo.NewMockRepository(ctrl)
+	r.EXPECT().ListConfigsByOwners(gomock.Any()).Return(configsByOwners, nil)
+	r.EXPECT().UpdateMergeChanceStates(gomock.Any(), gomock.Any()).AnyTimes().
+		DoAndReturn(func(ctx context.Context, config *model.RepositoryConfig) error {
+			persisted = append(persisted, config)
+			return nil
+		})
+	r.EXPECT().AddAuditEvents(gomock.Any(), gomock.Len(10)).Return(nil)
+
+	apps := githubapi.NewMockAppsService(ctrl)
+	apps.EXPECT().ListInstallations(gomock.Any(), gomock.Any()).Return(installations, nil, nil)
+	appClient := githubapi.NewMockClient(ctrl)
+	appClient.EXPECT().Apps().Return(apps)
+	prs := githubapi.NewMockPullRequestService(ctrl)
+	prs.EXPECT().List(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().
+		DoAndReturn(func(ctx context.Context, owner, name string, opts *github.PullRequestListOptions) ([]*github.PullRequest, *github.Response, error) {
+			time.Sleep(time.Millisecond)
+			if failing(name) {
+				return nil, nil, fmt.Errorf("oops")
+			}
+			return []*github.PullRequest{}, nil, nil
+		})
+	installClient := githubapi.NewMockClient(ctrl)
+	installClient.EXPECT().PullRequests().Return(prs).AnyTimes()
+	adapter := githubapps.NewMockGitHubAppsAdapter(ctrl)
+	adapter.EXPECT().NewAppClient().Return(appClient)
+	adapter.EXPECT().NewInstallationClient(gomock.Any()).Return(installClient).AnyTimes()
+
+	u := &usecaseImpl{repo: r, srv: newService(service.ReportCommitStatus), maxConcurrencyPerInstallation: 3}
+	ctx := logging.SetNilLogger(context.Background())
+	summary, err := u.UpdateChanceTime(ctx, adapter, baseTime)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(persisted) != 10 {
+		t.Errorf("persisted %d configs, want 10", len(persisted))
+	}
+	for _, cfg := range persisted {
+		if failing(cfg.Name) {
+			t.Errorf("%s/%s is persisted although its statuses are not updated", cfg.Owner, cfg.Name)
+		}
+		if !cfg.MergeAvailable {
+			t.Errorf("%s/%s is persisted without the reconciled state", cfg.Owner, cfg.Name)
+		}
+	}
+	if len(summary.Updated) != 10 || len(summary.Failures) != 10 {
+		t.Errorf("summary = %d updated, %d failures, want 10 and 10", len(summary.Updated), len(summary.Failures))
+	}
+}
+
+func Test_usecaseImpl_ForceMergeWindow(t *testing.T) {
+	now := time.Date(2020, time.February, 3, 12, 0, 0, 0, time.UTC) // Monday
+	schedules := &model.MergeChanceSchedules{
+		Monday: []*model.MergeChanceSchedule{{StartHour: 10, StopHour: 18}},
+	}
+	pr := &github.PullRequest{
+		Number: github.Int(1),
+		Base: &github.PullRequestBranch{
+			Repo: &github.Repository{
+				Name:  github.String("example-repo"),
+				Owner: &github.User{Login: github.String("aereal")},
+			},
+		},
+		// the head is in a fork, and the merge chance is reported on the base repository
+		Head: &github.PullRequestBranch{
+			SHA: github.String("0xdeadbeaf"),
+			Repo: &github.Repository{
+				Name:  github.String("example-repo"),
+				Owner: &github.User{Login: github.String("forker")},
+			},
+		},
+	}
+	installations := []*github.Installation{
+		{ID: github.Int64(1234), Account: &github.User{Login: github.String("aereal")}},
+	}
+	closed := &model.Override{MergeAvailable: false, Until: now.Add(time.Hour), CreatedBy: "aereal", CreatedAt: now}
+
+	tests := []struct {
+		name      string
+		override  *model.Override
+		repo      func(ctrl *gomock.Controller) repo.Repository
+		ghAdapter func(ctrl *gomock.Controller) githubapps.GitHubAppsAdapter
+		wantErr   error
+	}{
+		{
+			name:     "close",
+			override: closed,
+			repo: func(ctrl *gomock.Controller) repo.Repository {
+				r := repo.NewMockRepository(ctrl)
+				r.EXPECT().GetRepositoryConfig(gomock.Any(), "aereal", "example-repo").Return(&model.RepositoryConfig{Owner: "aereal", Name: "example-repo", Schedules: schedules, MergeAvailable: true}, nil)
+				r.EXPECT().UpdateMergeChanceStates(gomock.Any(), gomock.Eq(
+					&model.RepositoryConfig{Owner: "aereal", Name: "example-repo", Schedules: schedules, MergeAvailable: false, Override: closed},
+				)).Return(nil).Times(1)
+				r.EXPECT().AddAuditEvents(gomock.Any(), auditEventsMatcher{model.AuditEventOverrideSet, model.AuditEventMergeClosed}).Return(nil).Times(1)
+				return r
+			},
+			ghAdapter: func(ctrl *gomock.Controller) githubapps.GitHubAppsAdapter {
+				apps := githubapi.NewMockAppsService(ctrl)
+				apps.EXPECT().ListInstallations(gomock.Any(), gomock.Any()).Return(installations, nil, nil)
+				appClient := githubapi.NewMockClient(ctrl)
+				appClient.EXPECT().Apps().Return(apps)
+
+				prs := githubapi.NewMockPullRequestService(ctrl)
+				prs.EXPECT().List(gomock.Any(), "aereal", "example-repo", gomock.Any()).Return([]*github.PullRequest{pr}, nil, nil)
+				repos := githubapi.NewMockRepositoriesService(ctrl)
+				repos.EXPECT().
+					CreateStatus(gomock.Any(), "aereal", "example-repo", "0xdeadbeaf", statusStateMatcher("pending")).
+					Return(nil, nil, nil).
+					Times(1)
+				installClient := githubapi.NewMockClient(ctrl)
+				installClient.EXPECT().PullRequests().Return(prs)
+				installClient.EXPECT().Repositories().Return(repos)
+
+				a := githubapps.NewMockGitHubAppsAdapter(ctrl)
+				a.EXPECT().NewAppClient().Return(appClient)
+				a.EXPECT().NewInstallationClient(int64(1234)).Return(installClient)
+				return a
+			},
+		},
+		{
+			name:     "already expired",
+			override: &model.Override{MergeAvailable: false, Until: now, CreatedAt: now},
+			repo: func(ctrl *gomock.Controller) repo.Repository {
+				r := repo.NewMockRepository(ctrl)
+				r.EXPECT().GetRepositoryConfig(gomock.Any(), "aereal", "example-repo").Return(&model.RepositoryConfig{Owner: "aereal", Name: "example-repo", Schedules: schedules, MergeAvailable: true}, nil)
+				return r
+			},
+			ghAdapter: func(ctrl *gomock.Controller) githubapps.GitHubAppsAdapter {
+				return githubapps.NewMockGitHubAppsAdapter(ctrl)
+			},
+			wantErr: ErrInvalidInput,
+		},
+		{
+			name:     "not installed",
+			override: closed,
+			repo: func(ctrl *gomock.Controller) repo.Repository {
+				r := repo.NewMockRepository(ctrl)
+				r.EXPECT().GetRepositoryConfig(gomock.Any(), "aereal", "example-repo").Return(&model.RepositoryConfig{Owner: "aereal", Name: "example-repo", Schedules: schedules, MergeAvailable: true}, nil)
+				return r
+			},
+			ghAdapter: func(ctrl *gomock.Controller) githubapps.GitHubAppsAdapter {
+				apps := githubapi.NewMockAppsService(ctrl)
+				apps.EXPECT().ListInstallations(gomock.Any(), gomock.Any()).Return([]*github.Installation{}, nil, nil)
+				appClient := githubapi.NewMockClient(ctrl)
+				appClient.EXPECT().Apps().Return(apps)
+
+				a := githubapps.NewMockGitHubAppsAdapter(ctrl)
+				a.EXPECT().NewAppClient().Return(appClient)
+				return a
+			},
+			wantErr: ErrInstallationNotFound,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			u := &usecaseImpl{
+				repo: tt.repo(ctrl),
+				srv:  newService(service.ReportCommitStatus),
+			}
+			ctx := logging.SetNilLogger(context.Background())
+			if err := u.ForceMergeWindow(ctx, tt.ghAdapter(ctrl), "aereal", "example-repo", tt.override); !errors.Is(err, tt.wantErr) {
+				t.Errorf("usecaseImpl.ForceMergeWindow() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_usecaseImpl_UpdatePullRequestCommitStatus(t *testing.T) {
+	newPR := func(labels ...string) *github.PullRequest {
+		pr := &github.PullRequest{
+			Number: github.Int(1),
+			Base: &github.PullRequestBranch{
+				Ref: github.String("main"),
+				Repo: &github.Repository{
+					Name:  github.String("example-repo"),
+					Owner: &github.User{Login: github.String("aereal")},
+				},
+			},
+			// the head is in a fork, and the merge chance is reported on the base repository
+			Head: &github.PullRequestBranch{
+				SHA: github.String("0xdeadbeaf"),
+				Repo: &github.Repository{
+					Name:  github.String("example-repo"),
+					Owner: &github.User{Login: github.String("forker")},
+				},
+			},
+		}
+		for _, l := range labels {
+			pr.Labels = append(pr.Labels, &github.Label{Name: github.String(l)})
+		}
+		return pr
+	}
+	tests := []struct {
+		name            string
+		config          *model.RepositoryConfig
+		pr              *github.PullRequest
+		wantState       string
+		wantDescription string
+	}{
+		{
+			name:            "open",
+			config:          &model.RepositoryConfig{Owner: "aereal", Name: "example-repo", MergeAvailable: true},
+			pr:              newPR(),
+			wantState:       "success",
+			wantDescription: "open",
+		},
+		{
+			name:            "closed",
+			config:          &model.RepositoryConfig{Owner: "aereal", Name: "example-repo", MergeAvailable: false, BypassLabel: "hotfix"},
+			pr:              newPR("bug"),
+			wantState:       "pending",
+			wantDescription: "closed",
+		},
+		{
+			name:            "closed / bypassed",
+			config:          &model.RepositoryConfig{Owner: "aereal", Name: "example-repo", MergeAvailable: false, BypassLabel: "hotfix"},
+			pr:              newPR("bug", "hotfix"),
+			wantState:       "success",
+			wantDescription: "open",
+		},
+		{
+			name:            "closed / base branch gated",
+			config:          &model.RepositoryConfig{Owner: "aereal", Name: "example-repo", MergeAvailable: false, BaseBranchPatterns: []string{"main", "release/*"}},
+			pr:              newPR(),
+			wantState:       "pending",
+			wantDescription: "closed",
+		},
+		{
+			name:            "closed / base branch not gated",
+			config:          &model.RepositoryConfig{Owner: "aereal", Name: "example-repo", MergeAvailable: false, BaseBranchPatterns: []string{"release/*"}},
+			pr:              newPR(),
+			wantState:       "success",
+			wantDescription: "open",
+		},
+		{
+			name:            "closed / bypassing disabled",
+			config:          &model.RepositoryConfig{Owner: "aereal", Name: "example-repo", MergeAvailable: false},
+			pr:              newPR(""),
+			wantState:       "pending",
+			wantDescription: "closed",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			r := repo.NewMockRepository(ctrl)
+			r.EXPECT().GetRepositoryConfig(gomock.Any(), "aereal", "example-repo").Return(tt.config, nil)
+			repos := githubapi.NewMockRepositoriesService(ctrl)
+			repos.EXPECT().
+				CreateStatus(gomock.Any(), "aereal", "example-repo", "0xdeadbeaf", statusMatcher{state: tt.wantState, description: tt.wantDescription}).
+				Return(nil, nil, nil).
+				Times(1)
+			client := githubapi.NewMockClient(ctrl)
+			client.EXPECT().Repositories().Return(repos)
+
+			u := &usecaseImpl{repo: r, srv: newService(service.ReportCommitStatus)}
+			ctx := logging.SetNilLogger(context.Background())
+			if err := u.UpdatePullRequestCommitStatus(ctx, client, tt.pr); err != nil {
+				t.Errorf("usecaseImpl.UpdatePullRequestCommitStatus() error = %v", err)
+			}
+		})
+	}
+}
+
+func Test_usecaseImpl_UpdatePullRequestCommitStatus_checkRun(t *testing.T) {
+	pr := &github.PullRequest{
+		Number: github.Int(1),
+		Base: &github.PullRequestBranch{
+			Ref: github.String("main"),
+			Repo: &github.Repository{
+				Name:  github.String("example-repo"),
+				Owner: &github.User{Login: github.String("aereal")},
+			},
+		},
+		// the head is in a fork, and the merge chance is reported on the base repository
+		Head: &github.PullRequestBranch{
+			SHA: github.String("0xdeadbeaf"),
+			Repo: &github.Repository{
+				Name:  github.String("example-repo"),
+				Owner: &github.User{Login: github.String("forker")},
+			},
+		},
+	}
+	tests := []struct {
+		name   string
+		config *model.RepositoryConfig
+		want   checkRunMatcher
+	}{
+		{
+			name:   "open",
+			config: &model.RepositoryConfig{Owner: "aereal", Name: "example-repo", MergeAvailable: true},
+			want:   checkRunMatcher{status: "completed", conclusion: "success"},
+		},
+		{
+			name:   "closed",
+			config: &model.RepositoryConfig{Owner: "aereal", Name: "example-repo", MergeAvailable: false},
+			want:   checkRunMatcher{status: "in_progress", action: service.RequestOverrideActionID},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			r := repo.NewMockRepository(ctrl)
+			r.EXPECT().GetRepositoryConfig(gomock.Any(), "aereal", "example-repo").Return(tt.config, nil)
+			checks := githubapi.NewMockChecksService(ctrl)
+			checks.EXPECT().
+				CreateCheckRun(gomock.Any(), "aereal", "example-repo", tt.want).
+				Return(&github.CheckRun{}, nil, nil).
+				Times(1)
+			client := githubapi.NewMockClient(ctrl)
+			client.EXPECT().Checks().Return(checks)
+
+			u := &usecaseImpl{repo: r, srv: newService(service.ReportCheckRun)}
+			ctx := logging.SetNilLogger(context.Background())
+			if err := u.UpdatePullRequestCommitStatus(ctx, client, pr); err != nil {
+				t.Errorf("usecaseImpl.UpdatePullRequestCommitStatus() error = %v", err)
+			}
+		})
+	}
+}
+
+func Test_usecaseImpl_MigrateStatusContext(t *testing.T) {
+	pr := &github.PullRequest{
+		Number: github.Int(1),
+		Base: &github.PullRequestBranch{
+			Ref: github.String("main"),
+			Repo: &github.Repository{
+				Name:  github.String("example-repo"),
+				Owner: &github.User{Login: github.String("aereal")},
+			},
+		},
+		// the head is in a fork, and the merge chance is reported on the base repository
+		Head: &github.PullRequestBranch{
+			SHA: github.String("0xdeadbeaf"),
+			Repo: &github.Repository{
+				Name:  github.String("example-repo"),
+				Owner: &github.User{Login: github.String("forker")},
+			},
+		},
+	}
+	installations := []*github.Installation{
+		{ID: github.Int64(1234), Account: &github.User{Login: github.String("aereal")}},
+	}
+	tests := []struct {
+		name            string
+		config          *model.RepositoryConfig
+		previousContext string
+		ghAdapter       func(ctrl *gomock.Controller) githubapps.GitHubAppsAdapter
+		wantErr         error
+	}{
+		{
+			name:            "renamed",
+			config:          &model.RepositoryConfig{Owner: "aereal", Name: "example-repo", StatusContext: "release-freeze", ClosedDescription: "frozen"},
+			previousContext: "merge-chance-time",
+			ghAdapter: func(ctrl *gomock.Controller) githubapps.GitHubAppsAdapter {
+				apps := githubapi.NewMockAppsService(ctrl)
+				apps.EXPECT().ListInstallations(gomock.Any(), gomock.Any()).Return(installations, nil, nil)
+				appClient := githubapi.NewMockClient(ctrl)
+				appClient.EXPECT().Apps().Return(apps)
+
+				prs := githubapi.NewMockPullRequestService(ctrl)
+				prs.EXPECT().List(gomock.Any(), "aereal", "example-repo", gomock.Any()).Return([]*github.PullRequest{pr}, nil, nil)
+				repos := githubapi.NewMockRepositoriesService(ctrl)
+				gomock.InOrder(
+					repos.EXPECT().
+						CreateStatus(gomock.Any(), "aereal", "example-repo", "0xdeadbeaf", statusMatcher{context: "release-freeze", state: "pending", description: "frozen"}).
+						Return(nil, nil, nil),
+					repos.EXPECT().
+						CreateStatus(gomock.Any(), "aereal", "example-repo", "0xdeadbeaf", statusMatcher{context: "merge-chance-time", state: "success", description: "moved to release-freeze"}).
+						Return(nil, nil, nil),
+				)
+				installClient := githubapi.NewMockClient(ctrl)
+				installClient.EXPECT().PullRequests().Return(prs)
+				installClient.EXPECT().Repositories().Return(repos).Times(2)
+
+				a := githubapps.NewMockGitHubAppsAdapter(ctrl)
+				a.EXPECT().NewAppClient().Return(appClient)
+				a.EXPECT().NewInstallationClient(int64(1234)).Return(installClient)
+				return a
+			},
+		},
+		{
+			name:            "unchanged",
+			config:          &model.RepositoryConfig{Owner: "aereal", Name: "example-repo"},
+			previousContext: "merge-chance-time",
+			ghAdapter: func(ctrl *gomock.Controller) githubapps.GitHubAppsAdapter {
+				return githubapps.NewMockGitHubAppsAdapter(ctrl)
+			},
+		},
+		{
+			name:            "not installed",
+			config:          &model.RepositoryConfig{Owner: "aereal", Name: "example-repo", StatusContext: "release-freeze"},
+			previousContext: "merge-chance-time",
+			ghAdapter: func(ctrl *gomock.Controller) githubapps.GitHubAppsAdapter {
+				apps := githubapi.NewMockAppsService(ctrl)
+				apps.EXPECT().ListInstallations(gomock.Any(), gomock.Any()).Return([]*github.Installation{}, nil, nil)
+				appClient := githubapi.NewMockClient(ctrl)
+				appClient.EXPECT().Apps().Return(apps)
+
+				a := githubapps.NewMockGitHubAppsAdapter(ctrl)
+				a.EXPECT().NewAppClient().Return(appClient)
+				return a
+			},
+			wantErr: ErrInstallationNotFound,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			u := &usecaseImpl{repo: repo.NewMockRepository(ctrl), srv: newService(service.ReportCommitStatus)}
+			ctx := logging.SetNilLogger(context.Background())
+			if err := u.MigrateStatusContext(ctx, tt.ghAdapter(ctrl), tt.config, tt.previousContext, time.Now()); !errors.Is(err, tt.wantErr) {
+				t.Errorf("usecaseImpl.MigrateStatusContext() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_usecaseImpl_RequestOverride(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	targetRepo := &github.Repository{
+		Name:  github.String("example-repo"),
+		Owner: &github.User{Login: github.String("aereal")},
+	}
+	checkRun := &github.CheckRun{
+		ID:   github.Int64(42),
+		Name: github.String("merge-chance-time"),
+		Output: &github.CheckRunOutput{
+			Title:   github.String("Merges are closed"),
+			Summary: github.String("No merge window is scheduled."),
+		},
+	}
+	checks := githubapi.NewMockChecksService(ctrl)
+	checks.EXPECT().
+		UpdateCheckRun(gomock.Any(), "aereal", "example-repo", int64(42), gomock.Eq(github.UpdateCheckRunOptions{
+			Name: "merge-chance-time",
+			Output: &github.CheckRunOutput{
+				Title:   github.String("Merges are closed"),
+				Summary: github.String("No merge window is scheduled."),
+				Text:    github.String("@octocat requested an override of the merge chance."),
+			},
+		})).
+		Return(&github.CheckRun{}, nil, nil).
+		Times(1)
+	client := githubapi.NewMockClient(ctrl)
+	client.EXPECT().Checks().Return(checks)
+
+	r := repo.NewMockRepository(ctrl)
+	r.EXPECT().AddAuditEvents(gomock.Any(), auditEventsMatcher{model.AuditEventOverrideRequested}).
+		DoAndReturn(func(ctx context.Context, events []*model.AuditEvent) error {
+			if e := events[0]; e.Owner != "aereal" || e.Name != "example-repo" || e.Actor != "octocat" {
+				t.Errorf("unexpected audit event: %#v", e)
+			}
+			return nil
+		})
+
+	u := &usecaseImpl{repo: r, srv: newService(service.ReportCheckRun)}
+	ctx := logging.SetNilLogger(context.Background())
+	if err := u.RequestOverride(ctx, client, targetRepo, checkRun, "octocat"); err != nil {
+		t.Errorf("usecaseImpl.RequestOverride() error = %v", err)
+	}
+}
+
+type checkRunMatcher struct {
+	status     string
+	conclusion string
+	action     string
+}
+
+func (m checkRunMatcher) Matches(x interface{}) bool {
+	opts, ok := x.(github.CreateCheckRunOptions)
+	if !ok {
+		return false
+	}
+	if opts.Name != "merge-chance-time" || opts.HeadSHA != "0xdeadbeaf" || opts.GetStatus() != m.status || opts.GetConclusion() != m.conclusion {
+		return false
+	}
+	if m.action == "" {
+		return len(opts.Actions) == 0
+	}
+	return len(opts.Actions) == 1 && opts.Actions[0].Identifier == m.action
+}
+
+func (m checkRunMatcher) String() string {
+	return fmt.Sprintf("check run status=%s conclusion=%s action=%s", m.status, m.conclusion, m.action)
+}
+
+var adminOrigin = &url.URL{Scheme: "https", Host: "mergechancetime.app"}
+
+func newService(reporter service.Reporter) service.Service {
+	srv, err := service.New(reporter, adminOrigin)
+	if err != nil {
+		panic(err)
+	}
+	return srv
+}
+
+type statusStateMatcher string
+
+func (m statusStateMatcher) Matches(x interface{}) bool {
+	status, ok := x.(*github.RepoStatus)
+	if !ok {
+		return false
+	}
+	return status.GetState() == string(m)
+}
+
+func (m statusStateMatcher) String() string {
+	return "is a status with state " + string(m)
+}
+
+// statusMatcher matches a status linking to the repository page on the admin front-end.
+// The context defaults to model.DefaultStatusContext.
+type statusMatcher struct {
+	context     string
+	state       string
+	description string
+}
+
+func (m statusMatcher) Matches(x interface{}) bool {
+	status, ok := x.(*github.RepoStatus)
+	if !ok {
+		return false
+	}
+	statusContext := m.context
+	if statusContext == "" {
+		statusContext = model.DefaultStatusContext
+	}
+	return status.GetContext() == statusContext && status.GetState() == m.state && status.GetDescription() == m.description && status.GetTargetURL() == "https://mergechancetime.app/repos/aereal/example-repo"
+}
+
+func (m statusMatcher) String() string {
+	return fmt.Sprintf("is a status with context=%s state=%s description=%q", m.context, m.state, m.description)
+}
+
+func Test_usecaseImpl_CalendarFeed(t *testing.T) {
+	now := time.Date(2020, time.February, 5, 12, 0, 0, 0, time.UTC) // Wednesday
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	type event struct {
+		uid     string
+		start   time.Time
+		end     time.Time
+		rrule   string
+		exdates []time.Time
+	}
+	tests := []struct {
+		name    string
+		config  *model.RepositoryConfig
+		err     error
+		want    []event
+		wantErr error
+	}{
+		{
+			name: "windows and exceptions",
+			config: &model.RepositoryConfig{
+				Owner:    "aereal",
+				Name:     "example-repo",
+				TimeZone: "Asia/Tokyo",
+				Schedules: &model.MergeChanceSchedules{
+					Monday: []*model.MergeChanceSchedule{{StartHour: 10, StopHour: 12}, {StartHour: 13, StopHour: 18}},
+				},
+				Calendar: &model.Calendar{
+					ExceptionDates: []*model.ExceptionDate{{Date: model.Date{Year: 2020, Month: time.February, Day: 10}}},
+					FreezePeriods: []*model.FreezePeriod{
+						{Start: time.Date(2020, time.February, 1, 0, 0, 0, 0, time.UTC), End: time.Date(2020, time.February, 2, 0, 0, 0, 0, time.UTC)},
+						{Start: time.Date(2020, time.February, 20, 0, 0, 0, 0, time.UTC), End: time.Date(2020, time.February, 21, 0, 0, 0, 0, time.UTC)},
+					},
+				},
+				OwnerCalendar: &model.Calendar{
+					ExceptionDates: []*model.ExceptionDate{{Date: model.Date{Year: 2020, Month: time.February, Day: 11}}},
+				},
+			},
+			want: []event{
+				{
+					uid:     "aereal.example-repo.monday.1000@mergechancetime.app",
+					start:   time.Date(2020, time.February, 3, 10, 0, 0, 0, tokyo),
+					end:     time.Date(2020, time.February, 3, 12, 0, 0, 0, tokyo),
+					rrule:   "FREQ=WEEKLY",
+					exdates: []time.Time{time.Date(2020, time.February, 10, 10, 0, 0, 0, tokyo)},
+				},
+				{
+					uid:     "aereal.example-repo.monday.1300@mergechancetime.app",
+					start:   time.Date(2020, time.February, 3, 13, 0, 0, 0, tokyo),
+					end:     time.Date(2020, time.February, 3, 18, 0, 0, 0, tokyo),
+					rrule:   "FREQ=WEEKLY",
+					exdates: []time.Time{time.Date(2020, time.February, 10, 13, 0, 0, 0, tokyo)},
+				},
+				{
+					uid:   "aereal.example-repo.freeze.1582156800@mergechancetime.app",
+					start: time.Date(2020, time.February, 20, 0, 0, 0, 0, time.UTC),
+					end:   time.Date(2020, time.February, 21, 0, 0, 0, 0, time.UTC),
+				},
+			},
+		},
+		{
+			name:    "not found",
+			err:     repo.ErrNotFound,
+			wantErr: ErrConfigNotFound,
+		},
+		{
+			name: "revoked",
+			config: &model.RepositoryConfig{
+				Owner:          "aereal",
+				Name:           "example-repo",
+				Schedules:      &model.MergeChanceSchedules{},
+				FeedGeneration: 1,
+			},
+			wantErr: ErrFeedRevoked,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			r := repo.NewMockRepository(ctrl)
+			r.EXPECT().GetRepositoryConfig(gomock.Any(), "aereal", "example-repo").Return(tt.config, tt.err).Times(1)
+			u := &usecaseImpl{repo: r, srv: newService(service.ReportCommitStatus)}
+			ctx := logging.SetNilLogger(context.Background())
+			got, err := u.CalendarFeed(ctx, "aereal", "example-repo", 0, now)
+			if err != tt.wantErr {
+				t.Fatalf("usecaseImpl.CalendarFeed() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if len(got.Events) != len(tt.want) {
+				t.Fatalf("len(Events) = %d, want %d", len(got.Events), len(tt.want))
+			}
+			for i, want := range tt.want {
+				e := got.Events[i]
+				if e.UID != want.uid || e.RRule != want.rrule || !e.Start.Equal(want.start) || !e.End.Equal(want.end) {
+					t.Errorf("Events[%d] = %s %s - %s %q, want %s %s - %s %q", i, e.UID, e.Start, e.End, e.RRule, want.uid, want.start, want.end, want.rrule)
+				}
+				if len(e.ExDates) != len(want.exdates) {
+					t.Errorf("Events[%d].ExDates = %v, want %v", i, e.ExDates, want.exdates)
+					continue
+				}
+				for j := range want.exdates {
+					if !e.ExDates[j].Equal(want.exdates[j]) {
+						t.Errorf("Events[%d].ExDates[%d] = %s, want %s", i, j, e.ExDates[j], want.exdates[j])
+					}
+				}
+			}
+		})
+	}
+}